@@ -21,10 +21,23 @@ func (g Chain[T]) Filter(filterFunc islices.FilterFunc[T]) Chain[T] {
 	return Chain[T](islices.Filter(g.Seq(), filterFunc))
 }
 
+func (g Chain[T]) Normalize(fns ...func(T) T) Chain[T] {
+	return Chain[T](islices.Map(g.Seq(), func(v T) T {
+		for _, fn := range fns {
+			v = fn(v)
+		}
+		return v
+	}))
+}
+
 func (g Chain[T]) Collect() []T {
 	return slices.Collect(g.Seq())
 }
 
+func (g Chain[T]) OrDefault(defaultFn func() T) T {
+	return islices.OrDefault(g.Seq(), defaultFn)
+}
+
 type Mappable[T, V any] struct {
 	seq  iter.Seq[T]
 	none V
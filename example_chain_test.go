@@ -3,6 +3,7 @@ package it_test
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/gomoni/it"
 )
@@ -18,6 +19,26 @@ func ExampleChain() {
 	// Output: [aa aaa]
 }
 
+func ExampleChain_Normalize() {
+	n := []string{" Aa", "AAA! ", "a"}
+	ch := it.NewChain(slices.Values(n))
+	slice := ch.
+		Normalize(strings.TrimSpace, strings.ToLower, func(s string) string { return strings.Trim(s, "!") }).
+		Collect()
+	fmt.Println(slice)
+	// Output: [aa aaa a]
+}
+
+func ExampleChain_OrDefault() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	ch := it.NewChain(slices.Values(n))
+	v := ch.
+		Filter(func(s string) bool { return len(s) > 10 }).
+		OrDefault(func() string { return "none" })
+	fmt.Println(v)
+	// Output: none
+}
+
 func ExampleMappable() {
 	n := []string{"aa", "aaa", "aaaaaaa", "a"}
 	ch := it.NewMappable[string, int](slices.Values(n))
@@ -0,0 +1,37 @@
+package it_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gomoni/it"
+)
+
+func ExampleFailable() {
+	n := []string{"aa", "aaa", "b", "aaaaaaa"}
+	slice, err := it.NewFailable(slices.Values(n)).
+		Filter(func(s string) (bool, error) { return len(s) >= 2, nil }).
+		Map(func(s string) (string, error) {
+			if !strings.HasPrefix(s, "a") {
+				return "", errors.New("not an a: " + s)
+			}
+			return strings.ToUpper(s), nil
+		}).
+		Slice()
+	fmt.Println(slice, err)
+
+	slice, err = it.NewFailable(slices.Values(n)).
+		Map(func(s string) (string, error) {
+			if !strings.HasPrefix(s, "a") {
+				return "", errors.New("not an a: " + s)
+			}
+			return strings.ToUpper(s), nil
+		}).
+		Slice()
+	fmt.Println(slice, err)
+	// Output:
+	// [AA AAA AAAAAAA] <nil>
+	// [AA AAA] not an a: b
+}
@@ -0,0 +1,43 @@
+package it_test
+
+import (
+	"fmt"
+	"iter"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/gomoni/it"
+	"github.com/gomoni/it/islices"
+)
+
+type counter int
+
+func (c *counter) Inc() { *c++ }
+
+func ExampleWithMiddleware() {
+	atLeastTwo := it.Stage[string, string](func(seq iter.Seq[string]) iter.Seq[string] {
+		return islices.Filter(seq, func(s string) bool { return len(s) >= 2 })
+	})
+	// drop the time to get a stable output
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	var c counter
+	stage := it.WithMiddleware(atLeastTwo,
+		it.LoggingMiddleware[string](logger),
+		it.MetricsMiddleware[string](&c),
+	)
+
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	slice := slices.Collect(it.Apply(stage, slices.Values(n)))
+	fmt.Println(slice, c)
+	// Output:
+	// level=INFO msg="stage done" count=3
+	// [aa aaa aaaaaaa] 3
+}
@@ -0,0 +1,29 @@
+package it_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/gomoni/it"
+)
+
+func ExampleFilterSome() {
+	n := []it.Option[string]{it.Some("aa"), it.None[string](), it.Some("a")}
+	slice := slices.Collect(it.FilterSome(slices.Values(n)))
+	fmt.Println(slice)
+	// Output: [aa a]
+}
+
+func ExampleMapOption() {
+	n := []string{"1", "a", "42"}
+	s0 := it.MapOption(slices.Values(n), func(s string) it.Option[int] {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return it.None[int]()
+		}
+		return it.Some(i)
+	})
+	fmt.Println(slices.Collect(s0))
+	// Output: [1 42]
+}
@@ -0,0 +1,46 @@
+package it_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/gomoni/it"
+	"github.com/gomoni/it/islices"
+)
+
+func parse(s string) it.Result[int] {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return it.Err[int](err)
+	}
+	return it.Ok(i)
+}
+
+func ExampleFilterOk() {
+	n := []string{"1", "a", "42"}
+	s0 := it.FilterOk(islices.Map(slices.Values(n), parse))
+	fmt.Println(slices.Collect(s0))
+	// Output: [1 42]
+}
+
+func ExampleCollectResults() {
+	n := []it.Result[int]{parse("1"), parse("a"), parse("42")}
+	values, errs := it.CollectResults(slices.Values(n))
+	fmt.Println(values, errs)
+	// Output: [1 42] [strconv.Atoi: parsing "a": invalid syntax]
+}
+
+func ExampleMapResult() {
+	n := []it.Result[int]{parse("1"), parse("a"), parse("42")}
+	s0 := it.MapResult(slices.Values(n), func(i int) it.Result[string] {
+		return it.Ok(strconv.Itoa(i * 2))
+	})
+	for r := range s0 {
+		fmt.Println(r.Get())
+	}
+	// Output:
+	// 2 <nil>
+	//  strconv.Atoi: parsing "a": invalid syntax
+	// 84 <nil>
+}
@@ -0,0 +1,28 @@
+package it_test
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/gomoni/it"
+)
+
+func ExampleDrain() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	sink := it.NewSliceSink[string]()
+	if err := it.Drain(slices.Values(n), sink); err != nil {
+		panic(err)
+	}
+	fmt.Println(sink.Slice())
+
+	if err := it.Drain(slices.Values(n), it.NewWriterSink(os.Stdout, "- %s\n")); err != nil {
+		panic(err)
+	}
+	// Output:
+	// [aa aaa aaaaaaa a]
+	// - aa
+	// - aaa
+	// - aaaaaaa
+	// - a
+}
@@ -0,0 +1,82 @@
+package it_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gomoni/it"
+)
+
+func ExampleNewSliceSource() {
+	src := it.NewSliceSource([]string{"aa", "aaa", "a"})
+	fmt.Println(slices.Collect(src.Seq()))
+	if err := src.Reset(); err != nil {
+		panic(err)
+	}
+	fmt.Println(slices.Collect(src.Seq()))
+	// Output:
+	// [aa aaa a]
+	// [aa aaa a]
+}
+
+func ExampleNewFileSource() {
+	dir, err := os.MkdirTemp("", "it")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "lines.txt")
+	if err := os.WriteFile(path, []byte("aa\naaa\na\n"), 0o644); err != nil {
+		panic(err)
+	}
+
+	src := it.NewFileSource(path)
+	for line := range src.Seq() {
+		fmt.Println(line)
+		break
+	}
+	if err := src.Reset(); err != nil {
+		panic(err)
+	}
+	fmt.Println(slices.Collect(src.Seq()))
+	// Output:
+	// aa
+	// [aa aaa a]
+}
+
+func ExampleNewFileSource_error() {
+	src := it.NewFileSource(filepath.Join(os.TempDir(), "it-does-not-exist.txt"))
+	fmt.Println(slices.Collect(src.Seq()))
+	fmt.Println(errors.Is(src.Reset(), fs.ErrNotExist))
+	// Output:
+	// []
+	// true
+}
+
+func ExampleNewFileSource_longLine() {
+	dir, err := os.MkdirTemp("", "it")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "long.txt")
+	long := strings.Repeat("a", bufio.MaxScanTokenSize) + "\n"
+	if err := os.WriteFile(path, []byte("aa\n"+long), 0o644); err != nil {
+		panic(err)
+	}
+
+	src := it.NewFileSource(path)
+	fmt.Println(slices.Collect(src.Seq()))
+	fmt.Println(src.Reset())
+	fmt.Println(src.Reset())
+	// Output:
+	// [aa]
+	// bufio.Scanner: token too long
+	// <nil>
+}
@@ -0,0 +1,34 @@
+package it_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/gomoni/it"
+	"github.com/gomoni/it/islices"
+)
+
+func ExampleCompose() {
+	atLeastTwo := it.Stage[string, string](func(seq iter.Seq[string]) iter.Seq[string] {
+		return islices.Filter(seq, func(s string) bool { return len(s) >= 2 })
+	})
+	lengths := it.Stage[string, int](func(seq iter.Seq[string]) iter.Seq[int] {
+		return islices.Map(seq, func(s string) int { return len(s) })
+	})
+	pipeline := it.Compose(it.Compose(atLeastTwo, it.Identity[string]()), lengths)
+
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	slice := slices.Collect(it.Apply(pipeline, slices.Values(n)))
+	fmt.Println(slice)
+	// Output: [2 3 7]
+}
+
+func ExampleDerive() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	lengths := it.Derive(slices.Values(n), func(seq iter.Seq[string]) iter.Seq[int] {
+		return islices.Map(seq, func(s string) int { return len(s) })
+	})
+	fmt.Println(slices.Collect(lengths))
+	// Output: [2 3 7 1]
+}
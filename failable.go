@@ -0,0 +1,73 @@
+package it
+
+import (
+	"iter"
+	"slices"
+)
+
+// Failable is a builder of a sequence whose operations can fail. The
+// sequence stops at the first error, which is available from Err after the
+// iteration.
+type Failable[T any] struct {
+	seq iter.Seq[T]
+	err error
+}
+
+func NewFailable[T any](seq iter.Seq[T]) *Failable[T] {
+	return &Failable[T]{
+		seq: seq,
+	}
+}
+
+func (f *Failable[T]) Seq() iter.Seq[T] {
+	return f.seq
+}
+
+// Err returns the error which stopped the iteration, if any
+func (f *Failable[T]) Err() error {
+	return f.err
+}
+
+func (f *Failable[T]) Map(fn func(T) (T, error)) *Failable[T] {
+	seq := f.seq
+	f.seq = func(yield func(T) bool) {
+		for v := range seq {
+			w, err := fn(v)
+			if err != nil {
+				f.err = err
+				return
+			}
+			if !yield(w) {
+				return
+			}
+		}
+	}
+	return f
+}
+
+func (f *Failable[T]) Filter(fn func(T) (bool, error)) *Failable[T] {
+	seq := f.seq
+	f.seq = func(yield func(T) bool) {
+		for v := range seq {
+			shouldYield, err := fn(v)
+			if err != nil {
+				f.err = err
+				return
+			}
+			if !shouldYield {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return f
+}
+
+// Slice collects the elements up to the first error and returns the error
+func (f *Failable[T]) Slice() ([]T, error) {
+	f.err = nil
+	slice := slices.Collect(f.seq)
+	return slice, f.err
+}
@@ -0,0 +1,32 @@
+package iarrow_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/gomoni/it/iarrow"
+)
+
+func ExampleToArrow() {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "len", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	record, err := iarrow.ToArrow(slices.Values([]int64{2, 3, 7}), schema)
+	if err != nil {
+		panic(err)
+	}
+	defer record.Release()
+	fmt.Println(record.NumRows(), record.ColumnName(0))
+	// Output: 3 len
+}
+
+func ExampleFromArrow() {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "name", Type: arrow.BinaryTypes.String}}, nil)
+	record, err := iarrow.ToArrow(slices.Values([]string{"aa", "aaa", "a"}), schema)
+	if err != nil {
+		panic(err)
+	}
+	defer record.Release()
+	slice := slices.Collect(iarrow.FromArrow[string](record, "name"))
+	fmt.Println(slice)
+	// Output: [aa aaa a]
+}
@@ -0,0 +1,57 @@
+// Package iarrow defines iterators over Apache Arrow columnar data.
+
+package iarrow
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// FromArrow yields the non null values of the named column of record. T
+// must match the Go type of the column values, like int64 for an Int64
+// column. It panics if the column does not exist or is of a different type.
+func FromArrow[T any](record arrow.Record, col string) iter.Seq[T] {
+	indices := record.Schema().FieldIndices(col)
+	if len(indices) == 0 {
+		panic(fmt.Sprintf("iarrow: column %q not found", col))
+	}
+	arr := record.Column(indices[0])
+	values, ok := arr.(interface{ Value(int) T })
+	if !ok {
+		var zero T
+		panic(fmt.Sprintf("iarrow: column %q of type %s has no values of type %T", col, arr.DataType(), zero))
+	}
+	return func(yield func(T) bool) {
+		for i := range arr.Len() {
+			if arr.IsNull(i) {
+				continue
+			}
+			if !yield(values.Value(i)) {
+				return
+			}
+		}
+	}
+}
+
+// ToArrow builds a record of a single column schema from the sequence. The
+// returned record must be released by the caller.
+func ToArrow[T any](seq iter.Seq[T], schema *arrow.Schema) (arrow.Record, error) {
+	if schema.NumFields() != 1 {
+		return nil, fmt.Errorf("iarrow: schema must have a single field, got %d", schema.NumFields())
+	}
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	fb, ok := b.Field(0).(interface{ Append(T) })
+	if !ok {
+		var zero T
+		return nil, fmt.Errorf("iarrow: cannot append %T to a column of type %s", zero, schema.Field(0).Type)
+	}
+	for v := range seq {
+		fb.Append(v)
+	}
+	return b.NewRecordBatch(), nil
+}
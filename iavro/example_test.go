@@ -0,0 +1,42 @@
+package iavro_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gomoni/it/iavro"
+	"github.com/hamba/avro/v2"
+)
+
+type person struct {
+	Name string `avro:"name"`
+	Age  int    `avro:"age"`
+}
+
+const personSchema = `{
+	"type": "record",
+	"name": "person",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "int"}
+	]
+}`
+
+func ExampleDecodeAvro() {
+	var buf bytes.Buffer
+	enc, err := avro.NewEncoder(personSchema, &buf)
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range []person{{"bambino", 1}, {"senior", 22}} {
+		if err := enc.Encode(p); err != nil {
+			panic(err)
+		}
+	}
+	for p, err := range iavro.DecodeAvro[person](&buf, personSchema) {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
@@ -0,0 +1,39 @@
+// Package iavro defines iterators over streams of Apache Avro records.
+
+package iavro
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/hamba/avro/v2"
+)
+
+// DecodeAvro reads Avro binary encoded records of the given schema from r
+// and yields them decoded into T. The sequence stops after the first error.
+func DecodeAvro[T any](r io.Reader, schema string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		s, err := avro.Parse(schema)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		dec := avro.NewDecoderForSchema(s, r)
+		for {
+			var v T
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
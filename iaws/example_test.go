@@ -0,0 +1,36 @@
+package iaws_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/gomoni/it/iaws"
+)
+
+func ExampleListS3Objects() {
+	ctx := context.Background()
+	client := s3.New(s3.Options{Region: "eu-central-1"})
+	for obj, err := range iaws.ListS3Objects(ctx, client, "logs", "2024/07/") {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(aws.ToString(obj.Key), aws.ToInt64(obj.Size))
+	}
+}
+
+func ExampleReadS3Object() {
+	ctx := context.Background()
+	client := s3.New(s3.Options{Region: "eu-central-1"})
+	size := 0
+	for chunk, err := range iaws.ReadS3Object(ctx, client, "logs", "2024/07/01.log") {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		size += len(chunk)
+	}
+	fmt.Println(size)
+}
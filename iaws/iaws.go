@@ -0,0 +1,72 @@
+// Package iaws defines iterators over Amazon Web Services resources.
+
+package iaws
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// chunkSize is the maximum size of a chunk yielded by ReadS3Object
+const chunkSize = 32 * 1024
+
+// ListS3Objects yields the objects of bucket with the given key prefix. The
+// pages of ListObjectsV2 are fetched lazily, the sequence stops after the
+// first error.
+func ListS3Objects(ctx context.Context, client *s3.Client, bucket, prefix string) iter.Seq2[types.Object, error] {
+	return func(yield func(types.Object, error) bool) {
+		paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				yield(types.Object{}, err)
+				return
+			}
+			for _, obj := range page.Contents {
+				if !yield(obj, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ReadS3Object streams the body of the object in chunks. Each chunk is
+// a fresh allocation, so it may be retained by the caller. The body is
+// closed when the iteration ends, the sequence stops after the first error.
+func ReadS3Object(ctx context.Context, client *s3.Client, bucket, key string) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		out, err := client.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer out.Body.Close()
+		for {
+			chunk := make([]byte, chunkSize)
+			n, err := out.Body.Read(chunk)
+			if n > 0 && !yield(chunk[:n], nil) {
+				return
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
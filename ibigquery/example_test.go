@@ -0,0 +1,35 @@
+package ibigquery_test
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/gomoni/it/ibigquery"
+)
+
+type person struct {
+	Name string `bigquery:"name"`
+	Age  int    `bigquery:"age"`
+}
+
+func ExampleFromBigQuery() {
+	ctx := context.Background()
+	client, err := bigquery.NewClient(ctx, "my-project")
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	rows, err := client.Query("SELECT name, age FROM people.people WHERE age >= 18").Read(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for p, err := range ibigquery.FromBigQuery[person](rows) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
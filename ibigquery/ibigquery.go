@@ -0,0 +1,33 @@
+// Package ibigquery defines iterators over BigQuery query results.
+
+package ibigquery
+
+import (
+	"errors"
+	"iter"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// FromBigQuery yields the rows of the iterator loaded into T, which is
+// typically a struct with the fields matching the columns of the result.
+// The sequence ends on iterator.Done and stops after the first error.
+func FromBigQuery[T any](rows *bigquery.RowIterator) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			var v T
+			err := rows.Next(&v)
+			if errors.Is(err, iterator.Done) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
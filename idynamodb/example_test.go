@@ -0,0 +1,66 @@
+package idynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/gomoni/it/idynamodb"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func unmarshalPerson(item map[string]types.AttributeValue) (person, error) {
+	var p person
+	if name, ok := item["name"].(*types.AttributeValueMemberS); ok {
+		p.Name = name.Value
+	}
+	if age, ok := item["age"].(*types.AttributeValueMemberN); ok {
+		var err error
+		if p.Age, err = strconv.Atoi(age.Value); err != nil {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+func ExampleFromDynamoDBScan() {
+	ctx := context.Background()
+	client := dynamodb.New(dynamodb.Options{Region: "eu-central-1"})
+	input := &dynamodb.ScanInput{TableName: aws.String("people")}
+	for p, err := range idynamodb.FromDynamoDBScan(ctx, client, input, unmarshalPerson) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
+
+func ExampleFromDynamoDBQuery() {
+	ctx := context.Background()
+	client := dynamodb.New(dynamodb.Options{Region: "eu-central-1"})
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String("people"),
+		KeyConditionExpression: aws.String("#name = :name"),
+		ExpressionAttributeNames: map[string]string{
+			"#name": "name",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":name": &types.AttributeValueMemberS{Value: "senior"},
+		},
+	}
+	for p, err := range idynamodb.FromDynamoDBQuery(ctx, client, input, unmarshalPerson) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
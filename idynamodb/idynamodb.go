@@ -0,0 +1,54 @@
+// Package idynamodb defines iterators over Amazon DynamoDB scans and queries.
+
+package idynamodb
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// FromDynamoDBScan runs the scan and yields the items converted by
+// unmarshal. The pages are fetched lazily following LastEvaluatedKey. An
+// unmarshal error is yielded along with the zero value and the sequence
+// continues, a request error stops it.
+func FromDynamoDBScan[T any](ctx context.Context, client *dynamodb.Client, input *dynamodb.ScanInput, unmarshal func(map[string]types.AttributeValue) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		paginator := dynamodb.NewScanPaginator(client, input)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(unmarshal(item)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FromDynamoDBQuery is like FromDynamoDBScan for queries
+func FromDynamoDBQuery[T any](ctx context.Context, client *dynamodb.Client, input *dynamodb.QueryInput, unmarshal func(map[string]types.AttributeValue) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		paginator := dynamodb.NewQueryPaginator(client, input)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(unmarshal(item)) {
+					return
+				}
+			}
+		}
+	}
+}
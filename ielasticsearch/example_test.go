@@ -0,0 +1,28 @@
+package ielasticsearch_test
+
+import (
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/gomoni/it/ielasticsearch"
+)
+
+type person struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func ExampleFromElasticsearchScroll() {
+	client, err := elasticsearch.NewDefaultClient()
+	if err != nil {
+		panic(err)
+	}
+	query := `{"query": {"range": {"age": {"gte": 18}}}}`
+	for p, err := range ielasticsearch.FromElasticsearchScroll[person](client, "people", query, 500) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
@@ -0,0 +1,101 @@
+// Package ielasticsearch defines iterators over Elasticsearch search results.
+
+package ielasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// scrollKeepAlive is how long the search context is kept between the pages
+const scrollKeepAlive = time.Minute
+
+type scrollPage struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			Source json.RawMessage `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// FromElasticsearchScroll runs the JSON query against index and yields the
+// sources of the matching documents decoded into T. The results are fetched
+// lazily by pages of the given size using the Scroll API, the scroll is
+// cleared when the iteration ends. A document which cannot be decoded is
+// yielded as an error along with the zero value and the sequence continues,
+// other errors stop it.
+func FromElasticsearchScroll[T any](client *elasticsearch.Client, index, query string, size int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		ctx := context.Background()
+		res, err := client.Search(
+			client.Search.WithContext(ctx),
+			client.Search.WithIndex(index),
+			client.Search.WithBody(strings.NewReader(query)),
+			client.Search.WithSize(size),
+			client.Search.WithScroll(scrollKeepAlive),
+		)
+		page, err := decodePage(res, err)
+		if page.ScrollID != "" {
+			defer func() {
+				res, err := client.ClearScroll(
+					client.ClearScroll.WithContext(ctx),
+					client.ClearScroll.WithScrollID(page.ScrollID),
+				)
+				if err == nil {
+					res.Body.Close()
+				}
+			}()
+		}
+		for {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if len(page.Hits.Hits) == 0 {
+				return
+			}
+			for _, hit := range page.Hits.Hits {
+				var v T
+				err := json.Unmarshal(hit.Source, &v)
+				if err != nil {
+					v = zero
+				}
+				if !yield(v, err) {
+					return
+				}
+			}
+			var next scrollPage
+			next, err = decodePage(client.Scroll(
+				client.Scroll.WithContext(ctx),
+				client.Scroll.WithScrollID(page.ScrollID),
+				client.Scroll.WithScroll(scrollKeepAlive),
+			))
+			if next.ScrollID != "" {
+				page.ScrollID = next.ScrollID
+			}
+			page.Hits = next.Hits
+		}
+	}
+}
+
+func decodePage(res *esapi.Response, err error) (scrollPage, error) {
+	var page scrollPage
+	if err != nil {
+		return page, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return page, fmt.Errorf("ielasticsearch: %s", res.String())
+	}
+	err = json.NewDecoder(res.Body).Decode(&page)
+	return page, err
+}
@@ -0,0 +1,65 @@
+package igrpc_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gomoni/it/igrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream is a grpc.ClientStream replaying the names, then the err
+type fakeStream struct {
+	grpc.ClientStream
+	ctx   context.Context
+	names []string
+	err   error
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+
+func (s *fakeStream) recv() (string, error) {
+	if len(s.names) == 0 {
+		return "", s.err
+	}
+	name := s.names[0]
+	s.names = s.names[1:]
+	return name, nil
+}
+
+func ExampleFromGRPCStream_eof() {
+	stream := &fakeStream{ctx: context.Background(), names: []string{"aa", "aaa"}, err: io.EOF}
+	for name, err := range igrpc.FromGRPCStream(stream, stream.recv) {
+		fmt.Println(name, err)
+	}
+	// Output:
+	// aa <nil>
+	// aaa <nil>
+}
+
+func ExampleFromGRPCStream_deadlineExceeded() {
+	err := status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+	stream := &fakeStream{ctx: context.Background(), names: []string{"aa"}, err: err}
+	for name, err := range igrpc.FromGRPCStream(stream, stream.recv) {
+		fmt.Println(name, status.Code(err))
+	}
+	// Output:
+	// aa OK
+	//  DeadlineExceeded
+}
+
+func ExampleFromGRPCStream_cancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeStream{ctx: ctx, names: []string{"aa", "aaa"}, err: io.EOF}
+	for name, err := range igrpc.FromGRPCStream(stream, stream.recv) {
+		fmt.Println(name, err)
+		cancel()
+	}
+	// Output:
+	// aa <nil>
+	//  context canceled
+}
@@ -0,0 +1,45 @@
+package igrpc_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gomoni/it/igrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ExampleFromGRPCStream() {
+	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	desc := &grpc.StreamDesc{ServerStreams: true}
+	stream, err := conn.NewStream(ctx, desc, "/names.Names/List")
+	if err != nil {
+		panic(err)
+	}
+	if err := stream.SendMsg(wrapperspb.String("a*")); err != nil {
+		panic(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		panic(err)
+	}
+
+	recv := func() (*wrapperspb.StringValue, error) {
+		m := new(wrapperspb.StringValue)
+		return m, stream.RecvMsg(m)
+	}
+	for name, err := range igrpc.FromGRPCStream(stream, recv) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(name.GetValue())
+	}
+}
@@ -0,0 +1,39 @@
+// Package igrpc defines iterators over gRPC server streaming responses.
+
+package igrpc
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"google.golang.org/grpc"
+)
+
+// FromGRPCStream calls recv to receive the responses of stream and yields
+// them until the server closes the stream with io.EOF. The iteration stops
+// after the first error. A cancelled or expired stream context is yielded as
+// an error too, so a truncated stream is not mistaken for a complete one.
+func FromGRPCStream[T any](stream grpc.ClientStream, recv func() (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx := stream.Context()
+		for {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			v, err := recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
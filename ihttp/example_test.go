@@ -0,0 +1,41 @@
+package ihttp_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gomoni/it/ihttp"
+)
+
+type page struct {
+	Names []string `json:"names"`
+	Next  string   `json:"next"`
+}
+
+func ExampleFromHTTPPages() {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := page{Names: []string{"aa", "aaa"}, Next: srv.URL + "?page=2"}
+		if r.URL.Query().Get("page") == "2" {
+			p = page{Names: []string{"a"}}
+		}
+		_ = json.NewEncoder(w).Encode(p)
+	}))
+	defer srv.Close()
+
+	decode := func(resp *http.Response) ([]string, string, error) {
+		var p page
+		err := json.NewDecoder(resp.Body).Decode(&p)
+		return p.Names, p.Next, err
+	}
+	for name, err := range ihttp.FromHTTPPages(context.Background(), srv.URL, decode) {
+		fmt.Println(name, err)
+	}
+	// Output:
+	// aa <nil>
+	// aaa <nil>
+	// a <nil>
+}
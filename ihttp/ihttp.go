@@ -0,0 +1,47 @@
+// Package ihttp defines iterators over HTTP resources.
+
+package ihttp
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+// FromHTTPPages fetches the first URL and yields the elements decoded from
+// the response. The decode returns the elements of a page and the URL of the
+// next one, fetching continues until the next URL is empty. The pages are
+// fetched lazily with http.DefaultClient, checking the response status is up
+// to decode. The sequence stops after the first error.
+func FromHTTPPages[T any](ctx context.Context, first string, decode func(*http.Response) ([]T, string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for next := first; next != ""; {
+			var page []T
+			var err error
+			page, next, err = fetchPage(ctx, next, decode)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, v := range page {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func fetchPage[T any](ctx context.Context, url string, decode func(*http.Response) ([]T, string, error)) ([]T, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	return decode(resp)
+}
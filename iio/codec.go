@@ -0,0 +1,132 @@
+package iio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Codec pairs the functions encoding T into its wire form W and decoding
+// it back
+type Codec[T, W any] struct {
+	Encode func(T) (W, error)
+	Decode func(W) (T, error)
+}
+
+// JSONCodec returns a codec encoding the values as JSON
+func JSONCodec[T any]() Codec[T, []byte] {
+	return Codec[T, []byte]{
+		Encode: func(v T) ([]byte, error) {
+			return json.Marshal(v)
+		},
+		Decode: func(b []byte) (T, error) {
+			var v T
+			err := json.Unmarshal(b, &v)
+			return v, err
+		},
+	}
+}
+
+// GobCodec returns a codec encoding each value as a self contained gob
+func GobCodec[T any]() Codec[T, []byte] {
+	return Codec[T, []byte]{
+		Encode: func(v T) ([]byte, error) {
+			var buf bytes.Buffer
+			err := gob.NewEncoder(&buf).Encode(v)
+			return buf.Bytes(), err
+		},
+		Decode: func(b []byte) (T, error) {
+			var v T
+			err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v)
+			return v, err
+		},
+	}
+}
+
+// TextCodec returns a codec encoding the values using their
+// encoding.TextMarshaler implementation. Decode requires *T to implement
+// encoding.TextUnmarshaler and returns an error otherwise.
+func TextCodec[T encoding.TextMarshaler]() Codec[T, string] {
+	return Codec[T, string]{
+		Encode: func(v T) (string, error) {
+			b, err := v.MarshalText()
+			return string(b), err
+		},
+		Decode: func(s string) (T, error) {
+			var v T
+			u, ok := any(&v).(encoding.TextUnmarshaler)
+			if !ok {
+				return v, fmt.Errorf("iio: %T does not implement encoding.TextUnmarshaler", &v)
+			}
+			err := u.UnmarshalText([]byte(s))
+			return v, err
+		},
+	}
+}
+
+// MaxElementSize is the maximum size of an encoded element accepted by
+// EncodeCodec and DecodeCodec
+const MaxElementSize = 64 << 20
+
+// ErrTooLarge is returned for an element larger than MaxElementSize
+var ErrTooLarge = errors.New("iio: element too large")
+
+// EncodeCodec writes the elements of the sequence to w encoded by codec,
+// each prefixed by its uvarint encoded length
+func EncodeCodec[T any](seq iter.Seq[T], w io.Writer, codec Codec[T, []byte]) error {
+	return Encode(seq, w, func(v T, w io.Writer) error {
+		b, err := codec.Encode(v)
+		if err != nil {
+			return err
+		}
+		if len(b) > MaxElementSize {
+			return ErrTooLarge
+		}
+		var size [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(size[:], uint64(len(b)))
+		if _, err := w.Write(size[:n]); err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	})
+}
+
+// DecodeCodec reads the length prefixed elements written by EncodeCodec
+// from r and yields them decoded by codec. A length prefix above
+// MaxElementSize is yielded as ErrTooLarge and the sequence stops after the
+// first error.
+func DecodeCodec[T any](r io.Reader, codec Codec[T, []byte]) iter.Seq2[T, error] {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		b := bufio.NewReader(r)
+		r, br = b, b
+	}
+	return Decode(r, func(r io.Reader) (T, error) {
+		var zero T
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return zero, err
+		}
+		if size > MaxElementSize {
+			return zero, ErrTooLarge
+		}
+		// the buffer grows with the data read, so a short stream with
+		// a large prefix does not allocate the whole size upfront
+		var buf bytes.Buffer
+		if _, err := io.CopyN(&buf, r, int64(size)); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return zero, err
+		}
+		return codec.Decode(buf.Bytes())
+	})
+}
@@ -0,0 +1,91 @@
+package iio_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/gomoni/it/iio"
+)
+
+func ExampleJSONCodec() {
+	type point struct{ X, Y int }
+	codec := iio.JSONCodec[point]()
+	b, err := codec.Encode(point{X: 2, Y: 3})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(b))
+	p, err := codec.Decode(b)
+	fmt.Println(p, err)
+	// Output:
+	// {"X":2,"Y":3}
+	// {2 3} <nil>
+}
+
+func ExampleGobCodec() {
+	codec := iio.GobCodec[[]string]()
+	b, err := codec.Encode([]string{"aa", "aaa", "a"})
+	if err != nil {
+		panic(err)
+	}
+	s, err := codec.Decode(b)
+	fmt.Println(s, err)
+	// Output:
+	// [aa aaa a] <nil>
+}
+
+func ExampleTextCodec() {
+	codec := iio.TextCodec[netip.Addr]()
+	addr, err := codec.Decode("192.0.2.1")
+	fmt.Println(addr, err)
+	s, err := codec.Encode(addr)
+	fmt.Println(s, err)
+	// Output:
+	// 192.0.2.1 <nil>
+	// 192.0.2.1 <nil>
+}
+
+func ExampleDecodeCodec() {
+	type point struct{ X, Y int }
+	var buf bytes.Buffer
+	points := []point{{X: 2, Y: 3}, {X: 7, Y: 1}}
+	if err := iio.EncodeCodec(slices.Values(points), &buf, iio.JSONCodec[point]()); err != nil {
+		panic(err)
+	}
+	for p, err := range iio.DecodeCodec(&buf, iio.JSONCodec[point]()) {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {2 3} <nil>
+	// {7 1} <nil>
+}
+
+func ExampleDecodeCodec_truncated() {
+	var buf bytes.Buffer
+	if err := iio.EncodeCodec(slices.Values([]string{"aa", "aaa"}), &buf, iio.GobCodec[string]()); err != nil {
+		panic(err)
+	}
+	buf.Truncate(buf.Len() - 1)
+	for s, err := range iio.DecodeCodec(&buf, iio.GobCodec[string]()) {
+		fmt.Println(s, err)
+	}
+	// Output:
+	// aa <nil>
+	//  unexpected EOF
+}
+
+func ExampleDecodeCodec_corrupt() {
+	corrupt := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}
+	for s, err := range iio.DecodeCodec(bytes.NewReader(corrupt), iio.GobCodec[string]()) {
+		fmt.Printf("%q %v\n", s, err)
+	}
+	short := []byte{0x80, 0x80, 0x80, 0x10, 'a'}
+	for s, err := range iio.DecodeCodec(bytes.NewReader(short), iio.GobCodec[string]()) {
+		fmt.Printf("%q %v\n", s, err)
+	}
+	// Output:
+	// "" iio: element too large
+	// "" unexpected EOF
+}
@@ -0,0 +1,22 @@
+package iio_test
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/gomoni/it/iio"
+)
+
+func ExampleFromScanner() {
+	scanner := bufio.NewScanner(strings.NewReader("aa aaa\naaaaaaa a"))
+	scanner.Split(bufio.ScanWords)
+	for word, err := range iio.FromScanner(scanner) {
+		fmt.Println(word, err)
+	}
+	// Output:
+	// aa <nil>
+	// aaa <nil>
+	// aaaaaaa <nil>
+	// a <nil>
+}
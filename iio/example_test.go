@@ -0,0 +1,34 @@
+package iio_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/gomoni/it/iio"
+)
+
+func ExampleDecode() {
+	var buf bytes.Buffer
+	enc := func(v int32, w io.Writer) error { return binary.Write(w, binary.BigEndian, v) }
+	if err := iio.Encode(slices.Values([]int32{2, 3, 7}), &buf, enc); err != nil {
+		panic(err)
+	}
+	fmt.Println(buf.Len())
+
+	dec := func(r io.Reader) (int32, error) {
+		var v int32
+		err := binary.Read(r, binary.BigEndian, &v)
+		return v, err
+	}
+	for v, err := range iio.Decode(&buf, dec) {
+		fmt.Println(v, err)
+	}
+	// Output:
+	// 12
+	// 2 <nil>
+	// 3 <nil>
+	// 7 <nil>
+}
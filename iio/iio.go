@@ -0,0 +1,40 @@
+// Package iio defines iterators over readers and writers.
+
+package iio
+
+import (
+	"errors"
+	"io"
+	"iter"
+)
+
+// Encode writes the elements of the sequence one by one to w using enc
+func Encode[T any](seq iter.Seq[T], w io.Writer, enc func(T, io.Writer) error) error {
+	for v := range seq {
+		if err := enc(v, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads the elements from r one by one using dec and yields them.
+// The dec must return io.EOF when there are no more elements, the sequence
+// stops after the first other error.
+func Decode[T any](r io.Reader, dec func(io.Reader) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			v, err := dec(r)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
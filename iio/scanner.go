@@ -0,0 +1,22 @@
+package iio
+
+import (
+	"bufio"
+	"iter"
+)
+
+// FromScanner yields the tokens of a pre-configured scanner, so its split
+// function and buffer size decide what is yielded. The scanner error, if
+// any, is yielded along with an empty token after the last scan.
+func FromScanner(scanner *bufio.Scanner) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for scanner.Scan() {
+			if !yield(scanner.Text(), nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
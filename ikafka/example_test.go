@@ -0,0 +1,36 @@
+package ikafka_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gomoni/it/ikafka"
+	"github.com/segmentio/kafka-go"
+)
+
+type order struct {
+	ID    string  `json:"id"`
+	Total float64 `json:"total"`
+}
+
+func ExampleFromKafka() {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{"localhost:9092"},
+		GroupID: "billing",
+		Topic:   "orders",
+	})
+	defer reader.Close()
+
+	decode := func(msg *kafka.Message) (order, error) {
+		var o order
+		err := json.Unmarshal(msg.Value, &o)
+		return o, err
+	}
+	for o, err := range ikafka.FromKafka(reader, decode) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(o.ID, o.Total)
+	}
+}
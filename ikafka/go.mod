@@ -0,0 +1,14 @@
+module github.com/gomoni/it/ikafka
+
+go 1.23.0
+
+require github.com/segmentio/kafka-go v0.4.49
+
+require (
+	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/pierrec/lz4/v4 v4.1.22 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
+	github.com/stretchr/testify v1.11.0 // indirect
+	golang.org/x/net v0.43.0 // indirect
+)
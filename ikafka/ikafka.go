@@ -0,0 +1,66 @@
+// Package ikafka defines iterators over messages consumed from Kafka.
+
+package ikafka
+
+import (
+	"context"
+	"iter"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// CommitMode selects when the offsets of consumed messages are committed
+type CommitMode int
+
+const (
+	// CommitAfterYield commits the offset of a message once the consumer
+	// processed it, that is after the yield returns. The message which
+	// stopped the iteration is not committed, but the reader has already
+	// fetched past it. It is read again only by a new reader of the group,
+	// after a restart or a rebalance, not by reusing the same reader.
+	CommitAfterYield CommitMode = iota
+	// AutoCommit leaves the committing to the reader, which commits the
+	// offset when the message is read, possibly before it is processed
+	AutoCommit
+)
+
+// FromKafka yields the decoded messages read by reader, committing each
+// offset after the message is processed. A decode error is yielded along
+// with the zero value and the sequence continues, other errors stop it.
+func FromKafka[T any](reader *kafka.Reader, decode func(*kafka.Message) (T, error)) iter.Seq2[T, error] {
+	return FromKafkaContext(context.Background(), reader, decode, CommitAfterYield)
+}
+
+// FromKafkaContext is like FromKafka with the configurable commit mode.
+// Cancelling ctx stops the sequence and closes the reader.
+func FromKafkaContext[T any](ctx context.Context, reader *kafka.Reader, decode func(*kafka.Message) (T, error), mode CommitMode) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			var msg kafka.Message
+			var err error
+			if mode == AutoCommit {
+				msg, err = reader.ReadMessage(ctx)
+			} else {
+				msg, err = reader.FetchMessage(ctx)
+			}
+			if ctx.Err() != nil {
+				_ = reader.Close()
+				return
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(decode(&msg)) {
+				return
+			}
+			if mode == CommitAfterYield {
+				if err := reader.CommitMessages(ctx, msg); err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package imaps
+
+import "iter"
+
+// FromChannels pairs the elements received from keys and values. It
+// receives a key and then a value, blocking until both are available, and
+// stops when either channel is closed.
+func FromChannels[K, V any](keys <-chan K, values <-chan V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			k, ok := <-keys
+			if !ok {
+				return
+			}
+			v, ok := <-values
+			if !ok {
+				return
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
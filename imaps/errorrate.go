@@ -0,0 +1,37 @@
+package imaps
+
+import "iter"
+
+// ErrorRate drains the sequence and returns the number of pairs, the
+// number of non-nil errors and their ratio. The rate of an empty sequence
+// is 0.
+func ErrorRate[V any](s2 iter.Seq2[V, error]) (total, errors int, rate float64) {
+	for _, err := range s2 {
+		total++
+		if err != nil {
+			errors++
+		}
+	}
+	return total, errors, ratio(errors, total)
+}
+
+// ErrorRateWithValues is like ErrorRate, but it returns the values paired
+// with a nil error too
+func ErrorRateWithValues[V any](s2 iter.Seq2[V, error]) (values []V, total, errors int, rate float64) {
+	for v, err := range s2 {
+		total++
+		if err != nil {
+			errors++
+			continue
+		}
+		values = append(values, v)
+	}
+	return values, total, errors, ratio(errors, total)
+}
+
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
@@ -0,0 +1,27 @@
+package imaps_test
+
+import (
+	"fmt"
+
+	"github.com/gomoni/it/imaps"
+)
+
+func ExampleFromChannels() {
+	keys := make(chan string, 4)
+	values := make(chan int, 3)
+	for _, k := range []string{"aa", "aaa", "a", "aaaa"} {
+		keys <- k
+	}
+	close(keys)
+	for _, v := range []int{2, 3, 1} {
+		values <- v
+	}
+	close(values)
+	for k, v := range imaps.FromChannels(keys, values) {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// aa 2
+	// aaa 3
+	// a 1
+}
@@ -0,0 +1,25 @@
+package imaps_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/gomoni/it/imaps"
+)
+
+func ExampleErrorRate() {
+	s2 := imaps.Map(slices.All([]string{"2", "x", "7", "1"}), func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	fmt.Println(imaps.ErrorRate(s2))
+	// Output: 4 1 0.25
+}
+
+func ExampleErrorRateWithValues() {
+	s2 := imaps.Map(slices.All([]string{"2", "x", "7", "1"}), func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	fmt.Println(imaps.ErrorRateWithValues(s2))
+	// Output: [2 7 1] 4 1 0.25
+}
@@ -0,0 +1,31 @@
+package imaps_test
+
+import (
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+
+	imaps "github.com/gomoni/it/imaps"
+)
+
+func ExampleInstrument2() {
+	n := []string{"aa", "aaa", "a"}
+	// drop the time to get a stable output
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	s0 := imaps.Instrument2(slices.All(n), imaps.LogObserver2[int, string](logger))
+	_ = maps.Collect(s0)
+	// Output:
+	// level=DEBUG msg=pair key=0 value=aa
+	// level=DEBUG msg=pair key=1 value=aaa
+	// level=DEBUG msg=pair key=2 value=a
+	// level=INFO msg=done count=3
+}
@@ -0,0 +1,36 @@
+package imaps_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/gomoni/it/imaps"
+)
+
+func ExampleCollectAllErrors() {
+	s2 := imaps.Map(slices.All([]string{"2", "x", "7", "y"}), func(_ int, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	values, err := imaps.CollectAllErrors(s2)
+	fmt.Println(values)
+	var merr *imaps.MultiError
+	if errors.As(err, &merr) {
+		fmt.Println(len(merr.Errors()))
+	}
+	fmt.Println(errors.Is(err, strconv.ErrSyntax))
+	fmt.Println(err)
+	// Output:
+	// [2 7]
+	// 2
+	// true
+	// strconv.Atoi: parsing "x": invalid syntax
+	// strconv.Atoi: parsing "y": invalid syntax
+}
+
+func ExampleMultiError_zero() {
+	var merr imaps.MultiError
+	fmt.Println(merr.Error(), len(merr.Errors()))
+	// Output: imaps: no errors 0
+}
@@ -0,0 +1,26 @@
+package imaps_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	imaps "github.com/gomoni/it/imaps"
+)
+
+func ExampleRateLimit2() {
+	m := map[string]int{
+		"bambino": 1,
+		"junior":  11,
+		"senior":  22,
+	}
+	start := time.Now()
+	s0 := imaps.RateLimit2(maps.All(m), 100)
+	result := maps.Collect(s0)
+	fmt.Println(slices.Sorted(maps.Keys(result)))
+	fmt.Println(time.Since(start) >= 20*time.Millisecond)
+	// Output:
+	// [bambino junior senior]
+	// true
+}
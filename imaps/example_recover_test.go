@@ -0,0 +1,28 @@
+package imaps_test
+
+import (
+	"fmt"
+	"strconv"
+
+	imaps "github.com/gomoni/it/imaps"
+)
+
+func ExampleRecover2() {
+	parse := func(yield func(int, error) bool) {
+		for _, s := range []string{"1", "42", "boom"} {
+			if s == "boom" {
+				panic("cannot parse " + s)
+			}
+			if !yield(strconv.Atoi(s)) {
+				return
+			}
+		}
+	}
+	for v, err := range imaps.Recover2(parse) {
+		fmt.Println(v, err)
+	}
+	// Output:
+	// 1 <nil>
+	// 42 <nil>
+	// 0 panic: cannot parse boom
+}
@@ -0,0 +1,27 @@
+package imaps_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/imaps"
+	"github.com/gomoni/it/islices"
+)
+
+func ExampleToSeq() {
+	s2 := slices.All([]string{"aa", "aaa", "aaaaaaa", "a"})
+	seq := islices.Filter(imaps.ToSeq(s2), func(p struct {
+		K int
+		V string
+	}) bool {
+		return len(p.V) > 2
+	})
+	fmt.Println(slices.Collect(seq))
+	for k, v := range imaps.FromStruct(seq) {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// [{1 aaa} {2 aaaaaaa}]
+	// 1 aaa
+	// 2 aaaaaaa
+}
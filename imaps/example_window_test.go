@@ -0,0 +1,18 @@
+package imaps_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/imaps"
+)
+
+func ExampleWindowSeq2() {
+	s2 := slices.All([]string{"aa", "aaa", "a", "aaaa"})
+	for window := range imaps.WindowSeq2(s2, 3) {
+		fmt.Println(window)
+	}
+	// Output:
+	// [{0 aa} {1 aaa} {2 a}]
+	// [{1 aaa} {2 a} {3 aaaa}]
+}
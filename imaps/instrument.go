@@ -0,0 +1,58 @@
+package imaps
+
+import (
+	"iter"
+	"log/slog"
+)
+
+// Observer2 receives the pairs of an instrumented sequence
+type Observer2[K, V any] interface {
+	// OnPair is called for every pair before it is yielded
+	OnPair(K, V)
+	// OnDone is called when the iteration ends, either exhausted or stopped early
+	OnDone()
+}
+
+// Instrument2 reports the pairs of the sequence to obs
+func Instrument2[K, V any](s2 iter.Seq2[K, V], obs Observer2[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		defer obs.OnDone()
+		for k, v := range s2 {
+			obs.OnPair(k, v)
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+type logObserver2[K, V any] struct {
+	logger *slog.Logger
+	count  int
+}
+
+// LogObserver2 logs every pair at the debug level and their count at the
+// info level once the iteration ends
+func LogObserver2[K, V any](logger *slog.Logger) Observer2[K, V] {
+	return &logObserver2[K, V]{logger: logger}
+}
+
+func (o *logObserver2[K, V]) OnPair(k K, v V) {
+	o.count++
+	o.logger.Debug("pair", "key", k, "value", v)
+}
+
+func (o *logObserver2[K, V]) OnDone() {
+	o.logger.Info("done", "count", o.count)
+	o.count = 0
+}
+
+type nullObserver2[K, V any] struct{}
+
+// NullObserver2 ignores all the events
+func NullObserver2[K, V any]() Observer2[K, V] {
+	return nullObserver2[K, V]{}
+}
+
+func (nullObserver2[K, V]) OnPair(K, V) {}
+func (nullObserver2[K, V]) OnDone()     {}
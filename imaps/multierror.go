@@ -0,0 +1,48 @@
+package imaps
+
+import (
+	"errors"
+	"iter"
+)
+
+// MultiError holds all errors collected by CollectAllErrors
+type MultiError struct {
+	errs []error
+}
+
+// Error joins the messages of the errors by newlines
+func (e *MultiError) Error() string {
+	if len(e.errs) == 0 {
+		return "imaps: no errors"
+	}
+	return errors.Join(e.errs...).Error()
+}
+
+// Errors returns the collected errors
+func (e *MultiError) Errors() []error {
+	return e.errs
+}
+
+// Unwrap lets errors.Is and errors.As inspect the collected errors
+func (e *MultiError) Unwrap() []error {
+	return e.errs
+}
+
+// CollectAllErrors drains the sequence and returns the values paired with
+// a nil error. All non-nil errors are returned as a *MultiError, the error
+// is nil when there are none.
+func CollectAllErrors[V any](s2 iter.Seq2[V, error]) ([]V, error) {
+	var values []V
+	var errs []error
+	for v, err := range s2 {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(errs) == 0 {
+		return values, nil
+	}
+	return values, &MultiError{errs: errs}
+}
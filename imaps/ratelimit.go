@@ -0,0 +1,27 @@
+package imaps
+
+import (
+	"iter"
+	"time"
+)
+
+// RateLimit2 delays the pairs of the sequence so no more than rps of them
+// are yielded per second
+func RateLimit2[K, V any](s2 iter.Seq2[K, V], rps float64) iter.Seq2[K, V] {
+	if rps <= 0 {
+		panic("imaps: rate limit must be positive")
+	}
+	interval := time.Duration(float64(time.Second) / rps)
+	return func(yield func(K, V) bool) {
+		var next time.Time
+		for k, v := range s2 {
+			if wait := time.Until(next); wait > 0 {
+				time.Sleep(wait)
+			}
+			next = time.Now().Add(interval)
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
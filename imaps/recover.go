@@ -0,0 +1,38 @@
+package imaps
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Recover2 converts a panic of the source sequence into an error. When the
+// source panics while producing a pair, the pair (zero, err) is yielded
+// instead and the sequence ends, as the source cannot be resumed. Panics of
+// the consumer are not recovered.
+func Recover2[K any](s2 iter.Seq2[K, error]) iter.Seq2[K, error] {
+	return func(yield func(K, error) bool) {
+		inYield := false
+		stopped := false
+		defer func() {
+			if inYield || stopped {
+				return
+			}
+			if r := recover(); r != nil {
+				err, ok := r.(error)
+				if !ok {
+					err = fmt.Errorf("panic: %v", r)
+				}
+				var zero K
+				yield(zero, err)
+			}
+		}()
+		for k, err := range s2 {
+			inYield = true
+			if !yield(k, err) {
+				stopped = true
+				return
+			}
+			inYield = false
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package imaps
+
+import "iter"
+
+// ToSeq bundles each pair into a struct, so the single value operations
+// can be used on two value sequences
+func ToSeq[K, V any](s2 iter.Seq2[K, V]) iter.Seq[struct {
+	K K
+	V V
+}] {
+	return func(yield func(struct {
+		K K
+		V V
+	}) bool) {
+		for k, v := range s2 {
+			if !yield(struct {
+				K K
+				V V
+			}{k, v}) {
+				return
+			}
+		}
+	}
+}
+
+// FromStruct is the inverse of ToSeq, it unpacks each struct into a pair
+func FromStruct[K, V any](seq iter.Seq[struct {
+	K K
+	V V
+}]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for p := range seq {
+			if !yield(p.K, p.V) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package imaps
+
+import "iter"
+
+// KVPair holds a single key value tuple of a Seq2
+type KVPair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// WindowSeq2 yields overlapping windows of size consecutive pairs, each
+// window is a fresh slice. Nothing is yielded when s2 has less than size
+// pairs. Panics if size <= 0.
+func WindowSeq2[K, V any](s2 iter.Seq2[K, V], size int) iter.Seq[[]KVPair[K, V]] {
+	if size <= 0 {
+		panic("imaps: WindowSeq2 size must be positive")
+	}
+	return func(yield func([]KVPair[K, V]) bool) {
+		buf := make([]KVPair[K, V], 0, size)
+		for k, v := range s2 {
+			if len(buf) == size {
+				buf = buf[1:]
+			}
+			buf = append(buf, KVPair[K, V]{Key: k, Value: v})
+			if len(buf) < size {
+				continue
+			}
+			window := make([]KVPair[K, V], size)
+			copy(window, buf)
+			if !yield(window) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package imongo_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gomoni/it/imongo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type person struct {
+	Name string `bson:"name"`
+	Age  int    `bson:"age"`
+}
+
+func ExampleFromMongoCursor() {
+	ctx := context.Background()
+	client, err := mongo.Connect(options.Client().ApplyURI("mongodb://localhost:27017"))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Disconnect(ctx)
+
+	people := client.Database("test").Collection("people")
+	cursor, err := people.Find(ctx, bson.D{{Key: "age", Value: bson.D{{Key: "$gte", Value: 18}}}})
+	if err != nil {
+		panic(err)
+	}
+	for p, err := range imongo.FromMongoCursor[person](cursor) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
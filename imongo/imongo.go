@@ -0,0 +1,38 @@
+// Package imongo defines iterators over MongoDB cursors.
+
+package imongo
+
+import (
+	"context"
+	"iter"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// FromMongoCursor yields the documents of cursor decoded into T. A decode
+// error is yielded along with the zero value and the sequence continues, a
+// cursor error is yielded last. The cursor is closed when the iteration
+// ends.
+func FromMongoCursor[T any](cursor *mongo.Cursor) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx := context.Background()
+		defer cursor.Close(ctx)
+		for cursor.Next(ctx) {
+			var v T
+			if err := cursor.Decode(&v); err != nil {
+				var zero T
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := cursor.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package imsgpack_test
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/imsgpack"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func ExampleDecodeMsgpack() {
+	var buf bytes.Buffer
+	n := []person{{"bambino", 1}, {"senior", 22}}
+	if err := imsgpack.EncodeMsgpack(slices.Values(n), &buf); err != nil {
+		panic(err)
+	}
+	for p, err := range imsgpack.DecodeMsgpack[person](&buf) {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
@@ -0,0 +1,44 @@
+// Package imsgpack defines iterators over streams of MessagePack values.
+
+package imsgpack
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// DecodeMsgpack reads consecutive MessagePack values from r and yields them
+// decoded into T. The sequence stops after the first error.
+func DecodeMsgpack[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := msgpack.NewDecoder(r)
+		for {
+			var v T
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeMsgpack writes each element to w as a MessagePack value
+func EncodeMsgpack[T any](seq iter.Seq[T], w io.Writer) error {
+	enc := msgpack.NewEncoder(w)
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package inats_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gomoni/it/inats"
+	"github.com/nats-io/nats.go"
+)
+
+func ExampleFromNATS() {
+	conn, err := nats.Connect(nats.DefaultURL)
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	decode := func(data []byte) (int, error) { return strconv.Atoi(string(data)) }
+	seq, stop := inats.FromNATS(conn, "numbers", decode, 64)
+	defer stop()
+	for n, err := range seq {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if n < 0 {
+			break
+		}
+		fmt.Println(n)
+	}
+}
@@ -0,0 +1,50 @@
+// Package inats defines iterators over messages of NATS subjects.
+
+package inats
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+)
+
+// FromNATS subscribes to the subject and yields the decoded data of incoming
+// messages. Up to buf messages are buffered before the subscription becomes
+// a slow consumer. The returned function unsubscribes and ends the sequence.
+func FromNATS[T any](conn *nats.Conn, subject string, decode func([]byte) (T, error), buf int) (iter.Seq2[T, error], func()) {
+	return FromNATSContext(context.Background(), conn, subject, decode, buf)
+}
+
+// FromNATSContext is like FromNATS, cancelling ctx unsubscribes as well
+func FromNATSContext[T any](ctx context.Context, conn *nats.Conn, subject string, decode func([]byte) (T, error), buf int) (iter.Seq2[T, error], func()) {
+	ch := make(chan *nats.Msg, buf)
+	sub, err := conn.ChanSubscribe(subject, ch)
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			var zero T
+			yield(zero, err)
+		}, func() {}
+	}
+
+	done := make(chan struct{})
+	stop := sync.OnceFunc(func() {
+		_ = sub.Unsubscribe()
+		close(done)
+	})
+	context.AfterFunc(ctx, stop)
+
+	return func(yield func(T, error) bool) {
+		for {
+			select {
+			case <-done:
+				return
+			case msg := <-ch:
+				if !yield(decode(msg.Data)) {
+					return
+				}
+			}
+		}
+	}, stop
+}
@@ -0,0 +1,34 @@
+package iparquet_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gomoni/it/iparquet"
+	"github.com/parquet-go/parquet-go"
+)
+
+type person struct {
+	Name string `parquet:"name"`
+	Age  int    `parquet:"age"`
+}
+
+func ExampleReadParquet() {
+	dir, err := os.MkdirTemp("", "iparquet")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "people.parquet")
+	if err := parquet.WriteFile(path, []person{{"bambino", 1}, {"senior", 22}}); err != nil {
+		panic(err)
+	}
+
+	for p, err := range iparquet.ReadParquet[person](path) {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
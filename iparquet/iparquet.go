@@ -0,0 +1,59 @@
+// Package iparquet defines iterators over rows of Apache Parquet files.
+
+package iparquet
+
+import (
+	"errors"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// batchSize is the number of rows read from the file at once
+const batchSize = 64
+
+// ReadParquet opens the Parquet file at path and yields its rows decoded
+// into T. The file is closed when the iteration ends, the sequence stops
+// after the first error.
+func ReadParquet[T any](path string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		f, err := os.Open(path)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer f.Close()
+		stat, err := f.Stat()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		pf, err := parquet.OpenFile(f, stat.Size())
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		r := parquet.NewGenericReader[T](pf)
+		defer r.Close()
+
+		rows := make([]T, batchSize)
+		for {
+			n, err := r.Read(rows)
+			for _, row := range rows[:n] {
+				if !yield(row, nil) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package iproto_test
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/iproto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ExampleDecodeProto() {
+	var buf bytes.Buffer
+	n := []*wrapperspb.StringValue{wrapperspb.String("aa"), wrapperspb.String("aaa")}
+	if err := iproto.EncodeProto(slices.Values(n), &buf); err != nil {
+		panic(err)
+	}
+	for m, err := range iproto.DecodeProto(&buf, func() *wrapperspb.StringValue { return new(wrapperspb.StringValue) }) {
+		fmt.Println(m.GetValue(), err)
+	}
+	// Output:
+	// aa <nil>
+	// aaa <nil>
+}
@@ -0,0 +1,50 @@
+// Package iproto defines iterators over streams of Protocol Buffer messages.
+
+package iproto
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"iter"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+)
+
+// DecodeProto reads length delimited messages from r and yields them. The
+// factory returns a new empty message for each element. The sequence stops
+// after the first error.
+func DecodeProto[T proto.Message](r io.Reader, factory func() T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		br, ok := r.(protodelim.Reader)
+		if !ok {
+			br = bufio.NewReader(r)
+		}
+		for {
+			m := factory()
+			err := protodelim.UnmarshalFrom(br, m)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(m, nil) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeProto writes each message length delimited to w
+func EncodeProto[T proto.Message](seq iter.Seq[T], w io.Writer) error {
+	for m := range seq {
+		if _, err := protodelim.MarshalTo(w, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
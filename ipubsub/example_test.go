@@ -0,0 +1,38 @@
+package ipubsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/gomoni/it/ipubsub"
+)
+
+type order struct {
+	ID    string  `json:"id"`
+	Total float64 `json:"total"`
+}
+
+func ExampleFromPubSub() {
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, "my-project")
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	decode := func(msg *pubsub.Message) (order, error) {
+		var o order
+		err := json.Unmarshal(msg.Data, &o)
+		return o, err
+	}
+	sub := client.Subscriber("orders")
+	for o, err := range ipubsub.FromPubSub(ctx, sub, decode) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(o.ID, o.Total)
+	}
+}
@@ -0,0 +1,53 @@
+// Package ipubsub defines iterators over Google Cloud Pub/Sub messages.
+
+package ipubsub
+
+import (
+	"context"
+	"iter"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+// FromPubSub receives the messages of the subscription and yields them
+// decoded. Each message is acknowledged after the yield returns, a decode
+// error is yielded along with the zero value and the message is acknowledged
+// as well. Stopping the iteration or cancelling ctx stops the receiving, the
+// message which stopped the iteration and the messages received but not yet
+// yielded are not acknowledged, so they are delivered again.
+func FromPubSub[T any](ctx context.Context, sub *pubsub.Subscriber, decode func(*pubsub.Message) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		rctx, cancel := context.WithCancel(ctx)
+		msgs := make(chan *pubsub.Message)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- sub.Receive(rctx, func(_ context.Context, msg *pubsub.Message) {
+				select {
+				case msgs <- msg:
+				case <-rctx.Done():
+					msg.Nack()
+				}
+			})
+			// Receive returns after all the callbacks did
+			close(msgs)
+		}()
+		defer func() {
+			cancel()
+			for msg := range msgs {
+				msg.Nack()
+			}
+		}()
+
+		for msg := range msgs {
+			if !yield(decode(msg)) {
+				msg.Nack()
+				return
+			}
+			msg.Ack()
+		}
+		if err := <-errc; err != nil && ctx.Err() == nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
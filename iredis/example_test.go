@@ -0,0 +1,26 @@
+package iredis_test
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gomoni/it/iredis"
+	"github.com/redis/go-redis/v9"
+)
+
+func ExampleFromRedisStream() {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	defer client.Close()
+
+	decode := func(msg redis.XMessage) (int, error) {
+		s, _ := msg.Values["n"].(string)
+		return strconv.Atoi(s)
+	}
+	for n, err := range iredis.FromRedisStream(client, "numbers", "summers", "summer-1", decode) {
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(n)
+	}
+}
@@ -0,0 +1,81 @@
+// Package iredis defines iterators over entries of Redis streams.
+
+package iredis
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// batchSize is the maximum number of entries read by a single XREADGROUP
+const batchSize = 16
+
+// blockTimeout limits how long a single XREADGROUP waits for new entries
+const blockTimeout = time.Second
+
+// FromRedisStream reads the entries of the stream as the consumer of the
+// group and yields them decoded. It starts with the entries pending for the
+// consumer, left by an earlier stopped iteration, and then waits for new
+// ones, so the sequence ends only on error. Each entry is acknowledged after
+// the yield returns, the entries not acknowledged when the iteration stops
+// are yielded again by the next FromRedisStream of the same consumer. A
+// decode error is yielded along with the zero value and the sequence
+// continues.
+func FromRedisStream[T any](client *redis.Client, stream, group, consumer string, decode func(redis.XMessage) (T, error)) iter.Seq2[T, error] {
+	return FromRedisStreamContext(context.Background(), client, stream, group, consumer, decode)
+}
+
+// FromRedisStreamContext is like FromRedisStream, cancelling ctx ends the
+// sequence after the current read, which waits at most a second
+func FromRedisStreamContext[T any](ctx context.Context, client *redis.Client, stream, group, consumer string, decode func(redis.XMessage) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		// "0" reads the pending entries of the consumer and ">" new ones
+		start := "0"
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			args := &redis.XReadGroupArgs{
+				Group:    group,
+				Consumer: consumer,
+				Streams:  []string{stream, start},
+				Count:    batchSize,
+				Block:    blockTimeout,
+			}
+			streams, err := client.XReadGroup(ctx, args).Result()
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, redis.Nil) {
+				streams = nil
+			} else if err != nil {
+				yield(zero, err)
+				return
+			}
+			n := 0
+			for _, s := range streams {
+				for _, msg := range s.Messages {
+					n++
+					if !yield(decode(msg)) {
+						return
+					}
+					if err := client.XAck(ctx, stream, group, msg.ID).Err(); err != nil {
+						yield(zero, err)
+						return
+					}
+					if start != ">" {
+						start = msg.ID
+					}
+				}
+			}
+			if start != ">" && n == 0 {
+				start = ">"
+			}
+		}
+	}
+}
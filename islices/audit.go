@@ -0,0 +1,51 @@
+package islices
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"time"
+)
+
+// AuditRecord is a structured record of a single consumed element
+type AuditRecord[T any] struct {
+	// Index is a zero based position of the element in the sequence
+	Index int
+	// Time is when the element was consumed, in UTC
+	Time  time.Time
+	Value T
+}
+
+// Audit writes a line timestamped in UTC for every element to writer before
+// it is yielded along with a nil error. When writing the record fails, the
+// write error is yielded along with the zero value instead and the sequence
+// stops, so no element is ever consumed without being logged.
+func Audit[T any](seq iter.Seq[T], writer io.Writer, format func(T) string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v := range seq {
+			now := time.Now().UTC().Format(time.RFC3339Nano)
+			if _, err := fmt.Fprintf(writer, "%s %s\n", now, format(v)); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AuditTo sends an AuditRecord for every element to ch before it is yielded
+func AuditTo[T any](seq iter.Seq[T], ch chan<- AuditRecord[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		idx := 0
+		for v := range seq {
+			ch <- AuditRecord[T]{Index: idx, Time: time.Now().UTC(), Value: v}
+			idx++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package islices
+
+import "iter"
+
+// KVStore is a simple persistent key value storage
+type KVStore interface {
+	Get(key string) string
+	Put(key, value string)
+}
+
+// CheckpointStore saves the encoded element under key to store once it was
+// successfully processed, that is after the yield returns true
+func CheckpointStore[T any](seq iter.Seq[T], store KVStore, key string, encode func(T) string) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			store.Put(key, encode(v))
+		}
+	}
+}
+
+// ResumeFromStore skips the elements up to and including the one matching
+// the checkpoint saved under key by CheckpointStore. The whole sequence is
+// yielded if there is no checkpoint, nothing if no element matches it.
+func ResumeFromStore[T any](seq iter.Seq[T], store KVStore, key string, match func(T, string) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		checkpoint := store.Get(key)
+		resumed := checkpoint == ""
+		for v := range seq {
+			if !resumed {
+				resumed = match(v, checkpoint)
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
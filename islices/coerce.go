@@ -0,0 +1,68 @@
+package islices
+
+import (
+	"iter"
+	"math"
+	"reflect"
+)
+
+// Number is a constraint for the integer and floating point types
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Coerce converts each element to To with a plain Go conversion
+func Coerce[From, To Number](seq iter.Seq[From]) iter.Seq[To] {
+	return func(yield func(To) bool) {
+		for v := range seq {
+			if !yield(To(v)) {
+				return
+			}
+		}
+	}
+}
+
+// CoerceSafe converts each element to To and yields it along with true
+// when the conversion was lossless, that is the value converts back to
+// the original one and keeps its sign. Floating point values out of the
+// range of an integer type are never converted back, as such conversion
+// is implementation defined.
+func CoerceSafe[From, To Number](seq iter.Seq[From]) iter.Seq2[To, bool] {
+	fromFloat, toFloat := isFloat[From](), isFloat[To]()
+	return func(yield func(To, bool) bool) {
+		for v := range seq {
+			to := To(v)
+			var ok bool
+			switch {
+			case fromFloat && !inRange[To](float64(v)):
+			case toFloat && !inRange[From](float64(to)):
+			default:
+				ok = From(to) == v && (v < 0) == (to < 0)
+			}
+			if !yield(to, ok) {
+				return
+			}
+		}
+	}
+}
+
+func isFloat[T Number]() bool {
+	k := reflect.TypeFor[T]().Kind()
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// inRange reports whether f fits the range of T, which is always true for
+// the floating point types. NaN fits no integer type.
+func inRange[T Number](f float64) bool {
+	if isFloat[T]() {
+		return true
+	}
+	bits := int(reflect.TypeFor[T]().Size()) * 8
+	if T(0)-1 < 0 {
+		limit := math.Ldexp(1, bits-1)
+		return f >= -limit && f < limit
+	}
+	return f >= 0 && f < math.Ldexp(1, bits)
+}
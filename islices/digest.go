@@ -0,0 +1,24 @@
+package islices
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"iter"
+)
+
+// Digest drains the sequence and returns the hex encoded SHA256 of its
+// encoded elements. Each element is prefixed by its length, so the element
+// boundaries matter and ["a", "bc"] differs from ["ab", "c"]. Two sequences
+// have the same digest if they have the same elements in the same order.
+func Digest[T any](seq iter.Seq[T], encode func(T) []byte) string {
+	h := sha256.New()
+	var size [binary.MaxVarintLen64]byte
+	for v := range seq {
+		b := encode(v)
+		n := binary.PutUvarint(size[:], uint64(len(b)))
+		h.Write(size[:n])
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,74 @@
+package islices_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleAudit() {
+	var log strings.Builder
+	n := []string{"aa", "aaa", "a"}
+	s0 := islices.Audit(slices.Values(n), &log, func(s string) string { return "consumed " + s })
+	for s, err := range s0 {
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(s)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(log.String()))
+	for scanner.Scan() {
+		_, record, _ := strings.Cut(scanner.Text(), " ")
+		fmt.Println(record)
+	}
+	// Output:
+	// aa
+	// aaa
+	// a
+	// consumed aa
+	// consumed aaa
+	// consumed a
+}
+
+type failingWriter struct{ left int }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.left == 0 {
+		return 0, errors.New("disk full")
+	}
+	w.left--
+	return len(p), nil
+}
+
+func ExampleAudit_writeError() {
+	n := []string{"aa", "aaa", "a"}
+	s0 := islices.Audit(slices.Values(n), &failingWriter{left: 1}, func(s string) string { return s })
+	for s, err := range s0 {
+		fmt.Printf("%q %v\n", s, err)
+	}
+	// Output:
+	// "aa" <nil>
+	// "" disk full
+}
+
+func ExampleAuditTo() {
+	n := []string{"aa", "aaa", "a"}
+	ch := make(chan islices.AuditRecord[string], len(n))
+	s0 := islices.AuditTo(slices.Values(n), ch)
+	slice := slices.Collect(s0)
+	close(ch)
+	fmt.Println(slice)
+	for record := range ch {
+		fmt.Println(record.Index, record.Value)
+	}
+	// Output:
+	// [aa aaa a]
+	// 0 aa
+	// 1 aaa
+	// 2 a
+}
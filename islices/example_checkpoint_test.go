@@ -0,0 +1,38 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+type mapStore map[string]string
+
+func (m mapStore) Get(key string) string { return m[key] }
+func (m mapStore) Put(key, value string) { m[key] = value }
+
+func ExampleResumeFromStore() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	store := mapStore{}
+	encode := func(s string) string { return s }
+	match := func(s, checkpoint string) bool { return s == checkpoint }
+
+	// the first run fails after processing two elements
+	for s := range islices.CheckpointStore(slices.Values(n), store, "job", encode) {
+		if s == "aaaaaaa" {
+			break
+		}
+		fmt.Println("first", s)
+	}
+	// the second run resumes after the checkpoint
+	s0 := islices.ResumeFromStore(slices.Values(n), store, "job", match)
+	for s := range islices.CheckpointStore(s0, store, "job", encode) {
+		fmt.Println("second", s)
+	}
+	// Output:
+	// first aa
+	// first aaa
+	// second aaaaaaa
+	// second a
+}
@@ -0,0 +1,48 @@
+package islices_test
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleCoerce() {
+	n := []int{2, 3, 7}
+	fmt.Println(slices.Collect(islices.Coerce[int, float64](slices.Values(n))))
+	// Output: [2 3 7]
+}
+
+func ExampleCoerceSafe() {
+	n := []int{2, 300, -1}
+	for v, ok := range islices.CoerceSafe[int, uint8](slices.Values(n)) {
+		fmt.Println(v, ok)
+	}
+	// Output:
+	// 2 true
+	// 44 false
+	// 255 false
+}
+
+func ExampleCoerceSafe_float() {
+	n := []float64{2, 2.5, math.Ldexp(1, 63), math.NaN()}
+	for v, ok := range islices.CoerceSafe[float64, int64](slices.Values(n)) {
+		if !ok {
+			fmt.Println("lossy")
+			continue
+		}
+		fmt.Println(v, ok)
+	}
+	i := []int64{7, math.MaxInt64}
+	for v, ok := range islices.CoerceSafe[int64, float64](slices.Values(i)) {
+		fmt.Println(v, ok)
+	}
+	// Output:
+	// 2 true
+	// lossy
+	// lossy
+	// lossy
+	// 7 true
+	// 9.223372036854776e+18 false
+}
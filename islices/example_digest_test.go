@@ -0,0 +1,17 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleDigest() {
+	encode := func(s string) []byte { return []byte(s) }
+	d0 := islices.Digest(slices.Values([]string{"aa", "aaa", "a"}), encode)
+	d1 := islices.Digest(slices.Values([]string{"aa", "aaa", "a"}), encode)
+	d2 := islices.Digest(slices.Values([]string{"aaa", "aa", "a"}), encode)
+	fmt.Println(d0 == d1, d0 == d2)
+	// Output: true false
+}
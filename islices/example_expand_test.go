@@ -0,0 +1,22 @@
+package islices_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleExpand() {
+	abbrevs := map[string][]string{"asap": {"as", "soon", "as", "possible"}}
+	expand := func(word string) iter.Seq[string] {
+		if words, ok := abbrevs[word]; ok {
+			return slices.Values(words)
+		}
+		return islices.Return(word)
+	}
+	words := []string{"reply", "asap", "please"}
+	fmt.Println(slices.Collect(islices.Expand(slices.Values(words), expand)))
+	// Output: [reply as soon as possible please]
+}
@@ -0,0 +1,22 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleFuse() {
+	n := []string{"aa", "aaa", "a"}
+	s0 := islices.Fuse(slices.Values(n))
+	for s := range s0 {
+		fmt.Println(s)
+		break
+	}
+	slice := slices.Collect(s0)
+	fmt.Println(slice)
+	// Output:
+	// aa
+	// []
+}
@@ -0,0 +1,32 @@
+package islices_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleFuture() {
+	s0 := islices.Future(func() string {
+		fmt.Println("computing")
+		return "aaa"
+	})
+	fmt.Println(slices.Collect(s0))
+	fmt.Println(slices.Collect(s0))
+	// Output:
+	// computing
+	// [aaa]
+	// [aaa]
+}
+
+func ExampleFutureContext() {
+	s0 := islices.FutureContext(context.Background(), func(ctx context.Context) (int, error) {
+		return len("aaa"), ctx.Err()
+	})
+	for v, err := range s0 {
+		fmt.Println(v, err)
+	}
+	// Output: 3 <nil>
+}
@@ -0,0 +1,26 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleMapIf() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	s := islices.MapIf(slices.Values(n), func(s string) bool { return len(s) > 2 }, strings.ToUpper)
+	fmt.Println(slices.Collect(s))
+	// Output: [aa AAA AAAAAAA a]
+}
+
+func ExampleMapBoth() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	long := func(s string) bool { return len(s) > 2 }
+	s := islices.MapBoth(slices.Values(n), long,
+		func(s string) int { return len(s) },
+		func(s string) int { return -len(s) })
+	fmt.Println(slices.Collect(s))
+	// Output: [-2 3 7 -1]
+}
@@ -0,0 +1,47 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+type measurement struct {
+	City       string
+	Temp, Rain float64
+}
+
+func ExampleMelt() {
+	rows := []measurement{{"Prague", 21.5, 0.2}, {"Brno", 23, 0}}
+	id := func(m measurement) string { return m.City }
+	extract := func(m measurement, name string) any {
+		if name == "temp" {
+			return m.Temp
+		}
+		return m.Rain
+	}
+	for city, value := range islices.Melt(slices.Values(rows), id, []string{"temp", "rain"}, extract) {
+		fmt.Println(city, value)
+	}
+	// Output:
+	// Prague 21.5
+	// Prague 0.2
+	// Brno 23
+	// Brno 0
+}
+
+func ExampleCast() {
+	long := func(yield func(string, any) bool) {
+		_ = yield("Prague", 21.5) && yield("Prague", 0.2) && yield("Brno", 23.0) && yield("Brno", 0.0)
+	}
+	build := func(city string, values map[string]any) measurement {
+		return measurement{City: city, Temp: values["temp"].(float64), Rain: values["rain"].(float64)}
+	}
+	for m := range islices.Cast(long, []string{"temp", "rain"}, build) {
+		fmt.Println(m)
+	}
+	// Output:
+	// {Prague 21.5 0.2}
+	// {Brno 23 0}
+}
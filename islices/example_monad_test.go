@@ -0,0 +1,48 @@
+package islices_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleReturn() {
+	s0 := islices.Return("aaa")
+	fmt.Println(slices.Collect(s0))
+	// Output: [aaa]
+}
+
+func ExampleBind() {
+	n := []string{"a b", "c", "d e f"}
+	s0 := islices.Bind(slices.Values(n), func(s string) iter.Seq[string] {
+		return slices.Values(strings.Fields(s))
+	})
+	fmt.Println(slices.Collect(s0))
+	// Output: [a b c d e f]
+}
+
+func ExampleBind_laws() {
+	m := slices.Values([]int{1, 2})
+	f := func(i int) iter.Seq[int] { return slices.Values([]int{i, i * 10}) }
+	g := func(i int) iter.Seq[int] { return slices.Values([]int{i, -i}) }
+
+	fmt.Println(slices.Equal(
+		slices.Collect(islices.Bind(islices.Return(3), f)),
+		slices.Collect(f(3)),
+	))
+	fmt.Println(slices.Equal(
+		slices.Collect(islices.Bind(m, islices.Return)),
+		slices.Collect(m),
+	))
+	fmt.Println(slices.Equal(
+		slices.Collect(islices.Bind(islices.Bind(m, f), g)),
+		slices.Collect(islices.Bind(m, func(x int) iter.Seq[int] { return islices.Bind(f(x), g) })),
+	))
+	// Output:
+	// true
+	// true
+	// true
+}
@@ -0,0 +1,23 @@
+package islices_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleNestedMap() {
+	batches := []iter.Seq[string]{
+		slices.Values([]string{"aa", "aaa"}),
+		slices.Values([]string{"aaaaaaa", "a"}),
+	}
+	lens := islices.NestedMap(slices.Values(batches), func(s string) int { return len(s) })
+	for batch := range lens {
+		fmt.Println(slices.Collect(batch))
+	}
+	// Output:
+	// [2 3]
+	// [7 1]
+}
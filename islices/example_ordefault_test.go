@@ -0,0 +1,19 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleOrDefault() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	long := islices.Filter(slices.Values(n), func(s string) bool { return len(s) > 5 })
+	fmt.Println(islices.OrDefault(long, func() string { return "none" }))
+	huge := islices.Filter(slices.Values(n), func(s string) bool { return len(s) > 10 })
+	fmt.Println(islices.OrDefault(huge, func() string { return "none" }))
+	// Output:
+	// aaaaaaa
+	// none
+}
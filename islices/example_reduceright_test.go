@@ -0,0 +1,27 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+type list struct {
+	head string
+	tail *list
+}
+
+func ExampleReduceRight() {
+	n := []string{"aa", "aaa", "a"}
+	l := islices.ReduceRight(slices.Values(n), (*list)(nil), func(s string, l *list) *list {
+		return &list{head: s, tail: l}
+	})
+	for ; l != nil; l = l.tail {
+		fmt.Println(l.head)
+	}
+	// Output:
+	// aa
+	// aaa
+	// a
+}
@@ -0,0 +1,58 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleIndex() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	for i, s := range islices.Index(slices.Values(n), 1) {
+		if i > 3 {
+			break
+		}
+		fmt.Println(i, s)
+	}
+	// Output:
+	// 1 aa
+	// 2 aaa
+	// 3 aaaaaaa
+}
+
+func ExampleMapSeq2() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	s2 := islices.MapSeq2(slices.Values(n), func(s string) (string, int) { return s, len(s) })
+	for s, l := range s2 {
+		if l > 5 {
+			break
+		}
+		fmt.Println(s, l)
+	}
+	// Output:
+	// aa 2
+	// aaa 3
+}
+
+func ExampleWithError() {
+	n := []string{"aa", "aaa"}
+	for s, err := range islices.WithError(slices.Values(n)) {
+		fmt.Println(s, err)
+	}
+	// Output:
+	// aa <nil>
+	// aaa <nil>
+}
+
+func ExampleMapError() {
+	n := []string{"2", "x", "7"}
+	for v, err := range islices.MapError(slices.Values(n), strconv.Atoi) {
+		fmt.Println(v, err)
+	}
+	// Output:
+	// 2 <nil>
+	// 0 strconv.Atoi: parsing "x": invalid syntax
+	// 7 <nil>
+}
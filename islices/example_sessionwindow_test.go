@@ -0,0 +1,23 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleSessionWindow() {
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	// clicks in seconds from the start
+	clicks := []int{0, 10, 25, 100, 110, 300}
+	extractTime := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+	for session := range islices.SessionWindow(slices.Values(clicks), extractTime, 30*time.Second) {
+		fmt.Println(session)
+	}
+	// Output:
+	// [0 10 25]
+	// [100 110]
+	// [300]
+}
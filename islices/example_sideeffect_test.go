@@ -0,0 +1,17 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleSideEffect() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	seen := 0
+	s := islices.SideEffect(slices.Values(n), func(string) { seen++ })
+	s = islices.Filter(s, func(s string) bool { return len(s) <= 3 })
+	fmt.Println(slices.Collect(s), seen)
+	// Output: [aa aaa a] 4
+}
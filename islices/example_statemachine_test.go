@@ -0,0 +1,37 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleStateMachine() {
+	// recognizes quoted words in a stream of tokens
+	tokens := []string{"a", `"`, "b", "c", `"`, "d"}
+	transitions := map[string]func(string) string{
+		"outside": func(s string) string {
+			if s == `"` {
+				return "inside"
+			}
+			return "outside"
+		},
+		"inside": func(s string) string {
+			if s == `"` {
+				return "outside"
+			}
+			return "inside"
+		},
+	}
+	for token, state := range islices.StateMachine(slices.Values(tokens), "outside", transitions) {
+		fmt.Println(token, state)
+	}
+	// Output:
+	// a outside
+	// " inside
+	// b inside
+	// c inside
+	// " outside
+	// d outside
+}
@@ -0,0 +1,30 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+type printHooks struct{}
+
+func (printHooks) OnStart()           { fmt.Println("start") }
+func (printHooks) OnElement(s string) { fmt.Println("element", s) }
+func (printHooks) OnStop()            { fmt.Println("stop") }
+func (printHooks) OnError(err error)  { fmt.Println("error", err) }
+
+func ExampleWithTelemetry() {
+	n := []string{"aa", "aaa", "a"}
+	s0 := islices.WithTelemetry(slices.Values(n), printHooks{})
+	for s := range s0 {
+		if s == "aaa" {
+			break
+		}
+	}
+	// Output:
+	// start
+	// element aa
+	// element aaa
+	// stop
+}
@@ -0,0 +1,30 @@
+package islices_test
+
+import (
+	"fmt"
+	"net"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleUnmarshalText() {
+	n := []string{"127.0.0.1", "::1", "localhost"}
+	for ip, err := range islices.UnmarshalText[net.IP](slices.Values(n)) {
+		fmt.Println(ip, err)
+	}
+	// Output:
+	// 127.0.0.1 <nil>
+	// ::1 <nil>
+	// <nil> invalid IP address: localhost
+}
+
+func ExampleMarshalText() {
+	n := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	for s, err := range islices.MarshalText(slices.Values(n)) {
+		fmt.Println(s, err)
+	}
+	// Output:
+	// 127.0.0.1 <nil>
+	// ::1 <nil>
+}
@@ -0,0 +1,37 @@
+package islices_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+// countdown is a recursive sequence definition
+func countdown(n int) iter.Seq[int] {
+	if n < 0 {
+		return slices.Values([]int{})
+	}
+	return islices.Bind(slices.Values([]bool{false, true}), func(rest bool) iter.Seq[int] {
+		if !rest {
+			return islices.Return(n)
+		}
+		return islices.Thunk(func() iter.Seq[int] { return countdown(n - 1) })
+	})
+}
+
+func ExampleThunk() {
+	s0 := islices.Thunk(func() iter.Seq[string] {
+		fmt.Println("building")
+		return slices.Values([]string{"aa", "a"})
+	})
+	fmt.Println("built")
+	fmt.Println(slices.Collect(s0))
+	fmt.Println(slices.Collect(countdown(3)))
+	// Output:
+	// built
+	// building
+	// [aa a]
+	// [3 2 1 0]
+}
@@ -0,0 +1,39 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleTumblingWindow() {
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	// event times in seconds from the start
+	events := []int{0, 3, 1, 5, 4, 12, 2, 14}
+	extractTime := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+	for window := range islices.TumblingWindow(slices.Values(events), extractTime, 5*time.Second, time.Second) {
+		fmt.Println(window)
+	}
+	// Output:
+	// [0 3 1 4]
+	// [5]
+	// [12 14]
+}
+
+func ExampleSlidingWindow() {
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	events := []int{0, 2, 4, 6, 8}
+	extractTime := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+	for window := range islices.SlidingWindow(slices.Values(events), extractTime, 4*time.Second, 2*time.Second, 0) {
+		fmt.Println(window)
+	}
+	// Output:
+	// [0]
+	// [0 2]
+	// [2 4]
+	// [4 6]
+	// [6 8]
+	// [8]
+}
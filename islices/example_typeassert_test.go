@@ -0,0 +1,23 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleTypeAssert() {
+	values := []any{"aa", 2, "aaa", 3.5, nil, "a"}
+	strs := islices.TypeAssert[any, string](slices.Values(values))
+	fmt.Println(slices.Collect(strs))
+	// Output: [aa aaa a]
+}
+
+func ExampleTypeAssert_interface() {
+	values := []any{"aa", fmt.Errorf("boom"), 7}
+	for e := range islices.TypeAssert[any, error](slices.Values(values)) {
+		fmt.Println(e)
+	}
+	// Output: boom
+}
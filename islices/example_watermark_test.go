@@ -0,0 +1,26 @@
+package islices_test
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	islices "github.com/gomoni/it/islices"
+)
+
+func ExampleWatermark() {
+	start := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	// event times in seconds from the start
+	events := []int{0, 5, 3, 10, 4, 8}
+	extractTime := func(s int) time.Time { return start.Add(time.Duration(s) * time.Second) }
+	for event, onTime := range islices.Watermark(slices.Values(events), extractTime, 3*time.Second) {
+		fmt.Println(event, onTime)
+	}
+	// Output:
+	// 0 true
+	// 5 true
+	// 3 true
+	// 10 true
+	// 4 false
+	// 8 true
+}
@@ -0,0 +1,9 @@
+package islices
+
+import "iter"
+
+// Expand replaces each value by the sequence fn returns for it and yields
+// the values of those sequences. It is Bind restricted to a single type.
+func Expand[T any](seq iter.Seq[T], fn func(T) iter.Seq[T]) iter.Seq[T] {
+	return Bind(seq, fn)
+}
@@ -0,0 +1,21 @@
+package islices
+
+import "iter"
+
+// Fuse returns a sequence which never yields again once it was stopped
+// early. Exhausting the sequence does not blow the fuse, so a fully consumed
+// sequence may still be iterated again.
+func Fuse[T any](seq iter.Seq[T]) iter.Seq[T] {
+	blown := false
+	return func(yield func(T) bool) {
+		if blown {
+			return
+		}
+		for v := range seq {
+			if !yield(v) {
+				blown = true
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package islices
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// Future yields the result of fn as a single element. The fn is called at
+// most once, when the sequence is iterated for the first time.
+func Future[T any](fn func() T) iter.Seq[T] {
+	get := sync.OnceValue(fn)
+	return func(yield func(T) bool) {
+		yield(get())
+	}
+}
+
+// FutureContext is like Future for functions which can fail
+func FutureContext[T any](ctx context.Context, fn func(context.Context) (T, error)) iter.Seq2[T, error] {
+	get := sync.OnceValues(func() (T, error) { return fn(ctx) })
+	return func(yield func(T, error) bool) {
+		yield(get())
+	}
+}
@@ -10,6 +10,9 @@ type FilterFunc[T any] func(T) bool
 // MapFunc maps the T -> V
 type MapFunc[T, V any] func(T) V
 
+// MapFuncError maps the T -> V, error
+type MapFuncError[T, V any] func(T) (V, error)
+
 // Filter yields only values for which filterFunc returns true
 func Filter[T any](s iter.Seq[T], filterFunc FilterFunc[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
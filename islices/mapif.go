@@ -0,0 +1,36 @@
+package islices
+
+import "iter"
+
+// MapIf applies mapFunc to the values for which pred returns true and
+// yields the other values unchanged
+func MapIf[T any](seq iter.Seq[T], pred FilterFunc[T], mapFunc MapFunc[T, T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) {
+				v = mapFunc(v)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapBoth maps the values for which pred returns true by ifTrue and the
+// others by ifFalse, so every value is yielded
+func MapBoth[T, V any](seq iter.Seq[T], pred FilterFunc[T], ifTrue, ifFalse MapFunc[T, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			var w V
+			if pred(v) {
+				w = ifTrue(v)
+			} else {
+				w = ifFalse(v)
+			}
+			if !yield(w) {
+				return
+			}
+		}
+	}
+}
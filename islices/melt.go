@@ -0,0 +1,48 @@
+package islices
+
+import "iter"
+
+// Melt converts wide records into the long format. For every element it
+// yields the id of the element and the value of each variable in vars,
+// in the order of vars, obtained by extract.
+func Melt[T any](seq iter.Seq[T], id func(T) string, vars []string, extract func(T, string) any) iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		for v := range seq {
+			key := id(v)
+			for _, name := range vars {
+				if !yield(key, extract(v, name)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Cast is the inverse of Melt. It groups consecutive values with the same
+// id, assigns them to vars in order and builds a wide record of each group.
+// Variables missing from a short group are not present in the values map.
+func Cast[T any](s2 iter.Seq2[string, any], vars []string, build func(id string, values map[string]any) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var key string
+		var values map[string]any
+		n := 0
+		for k, v := range s2 {
+			if values != nil && (k != key || n == len(vars)) {
+				if !yield(build(key, values)) {
+					return
+				}
+				values = nil
+			}
+			if values == nil {
+				key, values, n = k, make(map[string]any, len(vars)), 0
+			}
+			if n < len(vars) {
+				values[vars[n]] = v
+			}
+			n++
+		}
+		if values != nil {
+			yield(build(key, values))
+		}
+	}
+}
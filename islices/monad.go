@@ -0,0 +1,32 @@
+package islices
+
+import "iter"
+
+// Return yields v as a single element
+func Return[T any](v T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		yield(v)
+	}
+}
+
+// Bind calls fn on each member of the sequence and yields all the elements
+// of the returned sequences, also known as flat map.
+//
+// Sequences form a monad with Return as unit and Bind as the bind
+// operation. For any value v, sequence m and functions f and g the
+// following laws hold, where equality means yielding the same elements:
+//
+//	left identity:  Bind(Return(v), f) == f(v)
+//	right identity: Bind(m, Return) == m
+//	associativity:  Bind(Bind(m, f), g) == Bind(m, func(x T) iter.Seq[W] { return Bind(f(x), g) })
+func Bind[T, V any](seq iter.Seq[T], fn func(T) iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			for w := range fn(v) {
+				if !yield(w) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package islices
+
+import "iter"
+
+// NestedMap maps the values of each inner sequence by mapFunc keeping the
+// nesting, so every inner sequence yields a mapped inner sequence
+func NestedMap[T, V any](seqs iter.Seq[iter.Seq[T]], mapFunc MapFunc[T, V]) iter.Seq[iter.Seq[V]] {
+	return func(yield func(iter.Seq[V]) bool) {
+		for seq := range seqs {
+			if !yield(Map(seq, mapFunc)) {
+				return
+			}
+		}
+	}
+}
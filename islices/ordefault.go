@@ -0,0 +1,12 @@
+package islices
+
+import "iter"
+
+// OrDefault returns the first value of the sequence or the result of
+// defaultFn when the sequence is empty. The defaultFn is called only then.
+func OrDefault[T any](seq iter.Seq[T], defaultFn func() T) T {
+	for v := range seq {
+		return v
+	}
+	return defaultFn()
+}
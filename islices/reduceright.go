@@ -0,0 +1,18 @@
+package islices
+
+import (
+	"iter"
+	"slices"
+)
+
+// ReduceRight collects the sequence and folds it from the last value to
+// the first one. Note the fn receives the value first and the accumulator
+// second.
+func ReduceRight[T, A any](seq iter.Seq[T], initial A, fn func(T, A) A) A {
+	s := slices.Collect(seq)
+	acc := initial
+	for i := len(s) - 1; i >= 0; i-- {
+		acc = fn(s[i], acc)
+	}
+	return acc
+}
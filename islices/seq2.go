@@ -0,0 +1,40 @@
+package islices
+
+import "iter"
+
+// Index yields each value along with its index, counting from start
+func Index[T any](seq iter.Seq[T], start int) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := start
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// MapSeq2 maps each value to a pair by fn
+func MapSeq2[T, K, V any](seq iter.Seq[T], fn func(T) (K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// WithError pairs each value with a nil error. Use it to pass a sequence
+// which cannot fail to stages consuming iter.Seq2[T, error], when the
+// values are transformed by a function which can fail use MapError instead.
+func WithError[T any](seq iter.Seq[T]) iter.Seq2[T, error] {
+	return MapSeq2(seq, func(v T) (T, error) { return v, nil })
+}
+
+// MapError maps each value by mapFunc and yields the result along with
+// the error
+func MapError[T, V any](seq iter.Seq[T], mapFunc MapFuncError[T, V]) iter.Seq2[V, error] {
+	return MapSeq2(seq, mapFunc)
+}
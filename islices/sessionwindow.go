@@ -0,0 +1,29 @@
+package islices
+
+import (
+	"iter"
+	"time"
+)
+
+// SessionWindow groups consecutive elements into sessions. A new session
+// starts when the time between two consecutive elements exceeds gap.
+func SessionWindow[T any](seq iter.Seq[T], extractTime func(T) time.Time, gap time.Duration) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		var session []T
+		var last time.Time
+		for v := range seq {
+			t := extractTime(v)
+			if len(session) > 0 && t.Sub(last) > gap {
+				if !yield(session) {
+					return
+				}
+				session = nil
+			}
+			session = append(session, v)
+			last = t
+		}
+		if len(session) > 0 {
+			yield(session)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package islices
+
+import "iter"
+
+// SideEffect calls fn for each value before yielding it unchanged. It marks
+// a pipeline stage which intentionally causes side effects.
+func SideEffect[T any](seq iter.Seq[T], fn func(T)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			fn(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package islices
+
+import "iter"
+
+// StateMachine runs the transition registered for the current state on each
+// element and yields the element along with the new state. A state without a
+// registered transition keeps the machine in that state.
+func StateMachine[T any, S comparable](seq iter.Seq[T], initial S, transitions map[S]func(T) S) iter.Seq2[T, S] {
+	return func(yield func(T, S) bool) {
+		state := initial
+		for v := range seq {
+			if transition, ok := transitions[state]; ok {
+				state = transition(v)
+			}
+			if !yield(v, state) {
+				return
+			}
+		}
+	}
+}
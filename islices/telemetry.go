@@ -0,0 +1,49 @@
+package islices
+
+import (
+	"fmt"
+	"iter"
+)
+
+// TelemetryHooks receives the lifecycle events of an observed sequence
+type TelemetryHooks[T any] interface {
+	// OnStart is called when the iteration begins
+	OnStart()
+	// OnElement is called for every element before it is yielded
+	OnElement(T)
+	// OnStop is called when the iteration ends, either exhausted or stopped early
+	OnStop()
+	// OnError is called when the source sequence panics
+	OnError(error)
+}
+
+// WithTelemetry reports the iteration of seq to hooks. A panic in seq is
+// reported via OnError and then propagated to the caller.
+func WithTelemetry[T any](seq iter.Seq[T], hooks TelemetryHooks[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		hooks.OnStart()
+		defer hooks.OnStop()
+		inYield := false
+		defer func() {
+			if inYield {
+				return
+			}
+			if r := recover(); r != nil {
+				err, ok := r.(error)
+				if !ok {
+					err = fmt.Errorf("panic: %v", r)
+				}
+				hooks.OnError(err)
+				panic(r)
+			}
+		}()
+		for v := range seq {
+			hooks.OnElement(v)
+			inYield = true
+			if !yield(v) {
+				return
+			}
+			inYield = false
+		}
+	}
+}
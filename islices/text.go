@@ -0,0 +1,38 @@
+package islices
+
+import (
+	"encoding"
+	"iter"
+)
+
+// UnmarshalText parses each string into a new T using its
+// encoding.TextUnmarshaler implementation and yields it along with the
+// parse error. The PT is a pointer to T and is inferred from T, so the
+// function is called like UnmarshalText[net.IP](seq).
+func UnmarshalText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](seq iter.Seq[string]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for s := range seq {
+			var v T
+			err := PT(&v).UnmarshalText([]byte(s))
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalText formats each element using its encoding.TextMarshaler
+// implementation and yields the text along with the error
+func MarshalText[T encoding.TextMarshaler](seq iter.Seq[T]) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for v := range seq {
+			b, err := v.MarshalText()
+			if !yield(string(b), err) {
+				return
+			}
+		}
+	}
+}
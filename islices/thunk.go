@@ -0,0 +1,20 @@
+package islices
+
+import (
+	"iter"
+	"sync"
+)
+
+// Thunk defers the construction of a sequence until it is iterated for the
+// first time. The fn is called at most once. This allows recursive sequence
+// definitions which would otherwise recurse infinitely on construction.
+func Thunk[T any](fn func() iter.Seq[T]) iter.Seq[T] {
+	get := sync.OnceValue(fn)
+	return func(yield func(T) bool) {
+		for v := range get() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
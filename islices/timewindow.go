@@ -0,0 +1,73 @@
+package islices
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// TumblingWindow groups elements into non-overlapping windows of the given
+// width, aligned to the multiples of width. A window is yielded once the
+// latest event time minus maxLate passes its end, elements arriving after
+// their window was yielded are dropped.
+func TumblingWindow[T any](seq iter.Seq[T], extractTime func(T) time.Time, width, maxLate time.Duration) iter.Seq[[]T] {
+	return timeWindows(seq, extractTime, width, width, maxLate)
+}
+
+// SlidingWindow groups elements into overlapping windows of the given width
+// starting at every multiple of step. Windows are yielded and late elements
+// dropped the same way as in TumblingWindow.
+func SlidingWindow[T any](seq iter.Seq[T], extractTime func(T) time.Time, width, step, maxLate time.Duration) iter.Seq[[]T] {
+	return timeWindows(seq, extractTime, width, step, maxLate)
+}
+
+type timeWindow[T any] struct {
+	start time.Time
+	items []T
+}
+
+func timeWindows[T any](seq iter.Seq[T], extractTime func(T) time.Time, width, step, maxLate time.Duration) iter.Seq[[]T] {
+	if width <= 0 || step <= 0 {
+		panic("islices: window width and step must be positive")
+	}
+	return func(yield func([]T) bool) {
+		// open windows sorted by their start
+		var open []timeWindow[T]
+		var latest, closedUntil time.Time
+		seen := false
+		for v := range seq {
+			t := extractTime(v)
+			if !seen || t.After(latest) {
+				latest = t
+				seen = true
+			}
+			for start := t.Truncate(step); start.Add(width).After(t); start = start.Add(-step) {
+				if !closedUntil.IsZero() && !start.Add(width).After(closedUntil) {
+					// late element, the window was already yielded
+					continue
+				}
+				idx, found := slices.BinarySearchFunc(open, start, func(w timeWindow[T], start time.Time) int {
+					return w.start.Compare(start)
+				})
+				if !found {
+					open = slices.Insert(open, idx, timeWindow[T]{start: start})
+				}
+				open[idx].items = append(open[idx].items, v)
+			}
+			watermark := latest.Add(-maxLate)
+			for len(open) > 0 && !open[0].start.Add(width).After(watermark) {
+				w := open[0]
+				open = open[1:]
+				closedUntil = w.start.Add(width)
+				if !yield(w.items) {
+					return
+				}
+			}
+		}
+		for _, w := range open {
+			if !yield(w.items) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package islices
+
+import "iter"
+
+// TypeAssert yields only the elements holding a value of type C, converted
+// to C by a type assertion. C may be an interface type too.
+func TypeAssert[T, C any](seq iter.Seq[T]) iter.Seq[C] {
+	return func(yield func(C) bool) {
+		for v := range seq {
+			c, ok := any(v).(C)
+			if !ok {
+				continue
+			}
+			if !yield(c) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package islices
+
+import (
+	"iter"
+	"time"
+)
+
+// Watermark yields each element along with true if it arrived on time, or
+// false if its event time is behind the watermark. The watermark is the
+// latest event time observed so far minus maxLate.
+func Watermark[T any](seq iter.Seq[T], extractTime func(T) time.Time, maxLate time.Duration) iter.Seq2[T, bool] {
+	return func(yield func(T, bool) bool) {
+		var latest time.Time
+		seen := false
+		for v := range seq {
+			t := extractTime(v)
+			onTime := !seen || !t.Before(latest.Add(-maxLate))
+			if !seen || t.After(latest) {
+				latest = t
+				seen = true
+			}
+			if !yield(v, onTime) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package isql_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gomoni/it/isql"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func ExampleFromSQLCursor() {
+	// the driver must be registered by importing it
+	db, err := sql.Open("postgres", "postgres://localhost/people")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	scan := func(rows *sql.Rows) (person, error) {
+		var p person
+		err := rows.Scan(&p.Name, &p.Age)
+		return p, err
+	}
+	query := "SELECT name, age FROM people WHERE age >= $1 ORDER BY name"
+	for p, err := range isql.FromSQLCursor(db, query, 100, scan, 18) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
+
+func ExampleFromQuery() {
+	// the driver must be registered by importing it
+	db, err := sql.Open("postgres", "postgres://localhost/people")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	scan := func(rows *sql.Rows) (person, error) {
+		var p person
+		err := rows.Scan(&p.Name, &p.Age)
+		return p, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	query := "SELECT name, age FROM people WHERE age >= $1"
+	for p, err := range isql.FromQuery(ctx, db, query, scan, 18) {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(p.Name, p.Age)
+	}
+}
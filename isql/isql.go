@@ -0,0 +1,80 @@
+// Package isql defines iterators over SQL query results.
+
+package isql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"iter"
+)
+
+// FromSQLCursor runs query page by page, appending LIMIT and OFFSET clauses
+// to it, and yields the rows converted by scan. Each page is read fully and
+// its rows closed before the elements are yielded, so no cursor is held open
+// between the pages. The query should have a stable ORDER BY for the pages
+// to be consistent. The sequence stops after the first error.
+func FromSQLCursor[T any](db *sql.DB, query string, pageSize int, scan func(*sql.Rows) (T, error), args ...any) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		panic("isql: page size must be positive")
+	}
+	return func(yield func(T, error) bool) {
+		var zero T
+		for offset := 0; ; offset += pageSize {
+			q := fmt.Sprintf("%s LIMIT %d OFFSET %d", query, pageSize, offset)
+			page, err := queryAll(context.Background(), db, q, scan, args...)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, v := range page {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if len(page) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// FromQuery runs the query and yields the rows converted by scan while they
+// are streamed from the database, so large results need not fit in memory.
+// It works with any database/sql driver. The rows are closed when the
+// iteration ends, the sequence stops after the first error.
+func FromQuery[T any](ctx context.Context, db *sql.DB, query string, scan func(*sql.Rows) (T, error), args ...any) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			v, err := scan(rows)
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+func queryAll[T any](ctx context.Context, db *sql.DB, query string, scan func(*sql.Rows) (T, error), args ...any) ([]T, error) {
+	var page []T
+	for v, err := range FromQuery(ctx, db, query, scan, args...) {
+		if err != nil {
+			return nil, err
+		}
+		page = append(page, v)
+	}
+	return page, nil
+}
@@ -0,0 +1,117 @@
+package isync
+
+import (
+	"iter"
+	"sync"
+)
+
+// EventBus publishes the elements of sequences to all its subscribers
+type EventBus[T any] struct {
+	bufSize  int
+	stopped  chan struct{}
+	stopOnce sync.Once
+
+	mu     sync.Mutex
+	closed bool
+	subs   []*subscriber[T]
+}
+
+type subscriber[T any] struct {
+	ch   chan T
+	done chan struct{}
+	once sync.Once
+}
+
+func (s *subscriber[T]) cancel() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// NewEventBus returns a new bus and a function stopping it. Each subscriber
+// buffers up to bufSize elements, a full buffer blocks the Publish.
+func NewEventBus[T any](bufSize int) (*EventBus[T], func()) {
+	b := &EventBus[T]{
+		bufSize: bufSize,
+		stopped: make(chan struct{}),
+	}
+	return b, b.stop
+}
+
+func (b *EventBus[T]) stop() {
+	// unblock the running Publish first, it holds the lock while sending
+	b.stopOnce.Do(func() { close(b.stopped) })
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, s := range b.subs {
+		close(s.ch)
+	}
+	b.subs = nil
+}
+
+// Publish drains seq and sends every element to all current subscribers. It
+// returns when seq is exhausted or the bus is stopped.
+func (b *EventBus[T]) Publish(seq iter.Seq[T]) {
+	for v := range seq {
+		if !b.send(v) {
+			return
+		}
+	}
+}
+
+func (b *EventBus[T]) send(v T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return false
+	}
+	subs := b.subs[:0]
+	for _, s := range b.subs {
+		select {
+		case s.ch <- v:
+			subs = append(subs, s)
+		case <-s.done:
+		case <-b.stopped:
+			return false
+		}
+	}
+	clear(b.subs[len(subs):])
+	b.subs = subs
+	return true
+}
+
+// Subscribe returns a sequence of all elements published after this call.
+// The sequence ends when the bus is stopped, stopping it early unsubscribes
+// it from the bus.
+func (b *EventBus[T]) Subscribe() iter.Seq[T] {
+	s := &subscriber[T]{
+		ch:   make(chan T, b.bufSize),
+		done: make(chan struct{}),
+	}
+	b.mu.Lock()
+	if b.closed {
+		close(s.ch)
+	} else {
+		b.subs = append(b.subs, s)
+	}
+	b.mu.Unlock()
+
+	return func(yield func(T) bool) {
+		for {
+			select {
+			case <-s.done:
+				return
+			case v, ok := <-s.ch:
+				if !ok {
+					return
+				}
+				if !yield(v) {
+					s.cancel()
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package isync_test
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/gomoni/it/isync"
+)
+
+func ExampleEventBus() {
+	bus, stop := isync.NewEventBus[string](1)
+	first := bus.Subscribe()
+	second := bus.Subscribe()
+
+	var wg sync.WaitGroup
+	var r1, r2 []string
+	wg.Add(2)
+	go func() { defer wg.Done(); r1 = slices.Collect(first) }()
+	go func() { defer wg.Done(); r2 = slices.Collect(second) }()
+
+	bus.Publish(slices.Values([]string{"aa", "aaa", "a"}))
+	stop()
+	wg.Wait()
+	fmt.Println(r1, r2)
+	// Output: [aa aaa a] [aa aaa a]
+}
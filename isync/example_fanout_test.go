@@ -0,0 +1,37 @@
+package isync_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/isync"
+)
+
+func ExampleFanOut() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	s0 := isync.FanOut(slices.Values(n), func(s string) int { return len(s) }, 2)
+	lens := slices.Collect(s0)
+	// the results are yielded in the order of completion
+	slices.Sort(lens)
+	fmt.Println(lens)
+	// Output: [1 2 3 7]
+}
+
+func ExampleFanOut_blockingSource() {
+	pending := make(chan string, 1)
+	defer close(pending)
+	pending <- "aa"
+	fromChan := func(yield func(string) bool) {
+		for v := range pending {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	// stopping does not wait for the producer blocked on pending
+	for l := range isync.FanOut(fromChan, func(s string) int { return len(s) }, 2) {
+		fmt.Println(l)
+		break
+	}
+	// Output: 2
+}
@@ -0,0 +1,18 @@
+package isync_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/isync"
+)
+
+func ExampleMapReduce() {
+	n := []string{"aa", "aaa", "aaaaaaa", "a"}
+	total := isync.MapReduce(slices.Values(n),
+		func(s string) int { return len(s) },
+		func(acc, v int) int { return acc + v },
+		0, 2)
+	fmt.Println(total)
+	// Output: 13
+}
@@ -0,0 +1,49 @@
+package isync_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/isync"
+)
+
+func ExampleMergeConcurrent() {
+	s0 := slices.Values([]int{1, 2, 3})
+	s1 := slices.Values([]int{10, 20})
+	s2 := slices.Values([]int{100})
+	merged := slices.Collect(isync.MergeConcurrent(context.Background(), s0, s1, s2))
+	// the order of arrival is not deterministic
+	slices.Sort(merged)
+	fmt.Println(merged)
+	// Output: [1 2 3 10 20 100]
+}
+
+func ExampleMergeConcurrent_blockingSource() {
+	idle := make(chan int)
+	defer close(idle)
+	fromChan := func(yield func(int) bool) {
+		for v := range idle {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	// stopping does not wait for the goroutine blocked on idle
+	for v := range isync.MergeConcurrent(context.Background(), fromChan, slices.Values([]int{1, 2, 3})) {
+		fmt.Println(v)
+		break
+	}
+
+	// neither does cancelling ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for v := range isync.MergeConcurrent(ctx, fromChan) {
+		fmt.Println(v)
+	}
+	fmt.Println("done")
+	// Output:
+	// 1
+	// done
+}
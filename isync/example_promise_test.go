@@ -0,0 +1,17 @@
+package isync_test
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gomoni/it/isync"
+)
+
+func ExamplePromise() {
+	p := isync.NewPromise(func() int { return len("aaa") })
+	fmt.Println(slices.Collect(p.Seq()))
+	fmt.Println(slices.Collect(p.Seq()))
+	// Output:
+	// [3]
+	// [3]
+}
@@ -0,0 +1,61 @@
+package isync
+
+import (
+	"iter"
+	"sync"
+)
+
+// FanOut applies fn to the elements of seq on the given number of worker
+// goroutines and fans their results back in to a single sequence. This is
+// the standard pattern for parallel CPU bound work. The results are yielded
+// in the order of completion, not in the order of seq. Stopping the
+// iteration returns immediately without waiting for the goroutines. The
+// goroutine reading a blocked source, like an idle channel, exits once the
+// source yields its next element or ends.
+func FanOut[T, V any](seq iter.Seq[T], fn func(T) V, workers int) iter.Seq[V] {
+	if workers < 1 {
+		workers = 1
+	}
+	return func(yield func(V) bool) {
+		in := make(chan T)
+		out := make(chan V)
+		done := make(chan struct{})
+		defer close(done)
+
+		go func() {
+			defer close(in)
+			for v := range seq {
+				select {
+				case in <- v:
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		var workersWg sync.WaitGroup
+		workersWg.Add(workers)
+		for range workers {
+			go func() {
+				defer workersWg.Done()
+				for v := range in {
+					select {
+					case out <- fn(v):
+					case <-done:
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			workersWg.Wait()
+			close(out)
+		}()
+
+		for v := range out {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
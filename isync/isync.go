@@ -0,0 +1,3 @@
+// Package isync defines iterators which are produced or consumed concurrently.
+
+package isync
@@ -0,0 +1,17 @@
+package isync
+
+import "iter"
+
+// MapReduce applies mapFn to the elements of seq on the given number of
+// worker goroutines and folds the results into initial with reduce. The
+// results arrive in the order of completion, so reduce must be associative
+// and commutative for the result to be deterministic. The reduce itself
+// runs on the calling goroutine. MapReduce drains seq, so it returns only
+// after seq ends.
+func MapReduce[T, V, A any](seq iter.Seq[T], mapFn func(T) V, reduce func(A, V) A, initial A, workers int) A {
+	acc := initial
+	for v := range FanOut(seq, mapFn, workers) {
+		acc = reduce(acc, v)
+	}
+	return acc
+}
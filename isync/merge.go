@@ -0,0 +1,50 @@
+package isync
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// MergeConcurrent drains all seqs concurrently and yields their elements in
+// the order of arrival. Cancelling ctx or stopping the iteration returns
+// immediately without waiting for the goroutines. A goroutine blocked in
+// its source, like one reading an idle channel, exits once the source
+// yields its next element or ends.
+func MergeConcurrent[T any](ctx context.Context, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		out := make(chan T)
+		defer cancel()
+		var wg sync.WaitGroup
+
+		for _, seq := range seqs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range seq {
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-out:
+				if !ok || !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package isync
+
+import "iter"
+
+// Promise holds the result of a function running in its own goroutine
+type Promise[T any] struct {
+	done  chan struct{}
+	value T
+}
+
+// NewPromise starts fn in a new goroutine immediately
+func NewPromise[T any](fn func() T) *Promise[T] {
+	p := &Promise[T]{
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		p.value = fn()
+	}()
+	return p
+}
+
+// Seq yields the result of the promise as a single element. It blocks until
+// the function completes, later iterations yield the cached result.
+func (p *Promise[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		<-p.done
+		yield(p.value)
+	}
+}
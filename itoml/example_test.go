@@ -0,0 +1,32 @@
+package itoml_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gomoni/it/itoml"
+)
+
+type person struct {
+	Name string `toml:"name"`
+	Age  int    `toml:"age"`
+}
+
+func ExampleFromTOMLArray() {
+	doc := `title = "people"
+
+[[person]]
+name = "bambino"
+age = 1
+
+[[person]]
+name = "senior"
+age = 22
+`
+	for p, err := range itoml.FromTOMLArray[person](strings.NewReader(doc), "person") {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
@@ -0,0 +1,45 @@
+// Package itoml defines iterators over arrays of TOML documents.
+
+package itoml
+
+import (
+	"io"
+	"iter"
+
+	"github.com/BurntSushi/toml"
+)
+
+// FromTOMLArray decodes the TOML document from r and yields the elements of
+// its top level array arrayKey decoded into T. This is typically an array of
+// tables declared by [[arrayKey]] sections. A missing key yields nothing,
+// the sequence stops after the first error.
+func FromTOMLArray[T any](r io.Reader, arrayKey string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var doc map[string]toml.Primitive
+		md, err := toml.NewDecoder(r).Decode(&doc)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		prim, ok := doc[arrayKey]
+		if !ok {
+			return
+		}
+		var elems []toml.Primitive
+		if err := md.PrimitiveDecode(prim, &elems); err != nil {
+			yield(zero, err)
+			return
+		}
+		for _, elem := range elems {
+			var v T
+			if err := md.PrimitiveDecode(elem, &v); err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
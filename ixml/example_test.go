@@ -0,0 +1,42 @@
+package ixml_test
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gomoni/it/ixml"
+)
+
+type person struct {
+	Name string `xml:"name,attr"`
+	Age  int    `xml:"age"`
+}
+
+func ExampleDecodeXML() {
+	doc := `<people>
+	<person name="bambino"><age>1</age></person>
+	<person name="senior"><age>22</age></person>
+</people>`
+	for p, err := range ixml.DecodeXML[person](strings.NewReader(doc), "person") {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
+
+func ExampleEncodeXML() {
+	n := []person{{"bambino", 1}, {"senior", 22}}
+	if err := ixml.EncodeXML(slices.Values(n), os.Stdout, "  "); err != nil {
+		panic(err)
+	}
+	// Output:
+	// <person name="bambino">
+	//   <age>1</age>
+	// </person>
+	// <person name="senior">
+	//   <age>22</age>
+	// </person>
+}
@@ -0,0 +1,56 @@
+// Package ixml defines iterators over streams of XML elements.
+
+package ixml
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"iter"
+)
+
+// DecodeXML scans r for elements with the given local name and yields each
+// of them unmarshalled into T, without loading the whole document. Nested
+// matching elements are decoded as a part of their matching parent. The
+// sequence stops after the first error.
+func DecodeXML[T any](r io.Reader, localName string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := xml.NewDecoder(r)
+		for {
+			tok, err := dec.Token()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			start, ok := tok.(xml.StartElement)
+			if !ok || start.Name.Local != localName {
+				continue
+			}
+			var v T
+			if err := dec.DecodeElement(&v, &start); err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeXML writes each element to w as an XML fragment. Each level of
+// nesting is indented by indent, an empty indent disables the indentation.
+func EncodeXML[T any](seq iter.Seq[T], w io.Writer, indent string) error {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", indent)
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return enc.Close()
+}
@@ -0,0 +1,43 @@
+package iyaml_test
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gomoni/it/iyaml"
+)
+
+type person struct {
+	Name string `yaml:"name"`
+	Age  int    `yaml:"age"`
+}
+
+func ExampleDecodeYAML() {
+	docs := `name: bambino
+age: 1
+---
+name: senior
+age: 22
+`
+	for p, err := range iyaml.DecodeYAML[person](strings.NewReader(docs)) {
+		fmt.Println(p, err)
+	}
+	// Output:
+	// {bambino 1} <nil>
+	// {senior 22} <nil>
+}
+
+func ExampleEncodeYAML() {
+	n := []person{{"bambino", 1}, {"senior", 22}}
+	if err := iyaml.EncodeYAML(slices.Values(n), os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output:
+	// name: bambino
+	// age: 1
+	// ---
+	// name: senior
+	// age: 22
+}
@@ -0,0 +1,44 @@
+// Package iyaml defines iterators over multi document YAML streams.
+
+package iyaml
+
+import (
+	"errors"
+	"io"
+	"iter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DecodeYAML reads the documents separated by --- from r and yields each of
+// them decoded into T. The sequence stops after the first error.
+func DecodeYAML[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := yaml.NewDecoder(r)
+		for {
+			var v T
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeYAML writes each element to w as a separate YAML document
+func EncodeYAML[T any](seq iter.Seq[T], w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	for v := range seq {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return enc.Close()
+}
@@ -0,0 +1,63 @@
+package it
+
+import (
+	"iter"
+	"log/slog"
+)
+
+// Middleware wraps a stage with a cross-cutting concern like logging or
+// metrics
+type Middleware[T any] func(Stage[T, T]) Stage[T, T]
+
+// WithMiddleware wraps the stage with mws, the first middleware is the
+// outermost one
+func WithMiddleware[T any](stage Stage[T, T], mws ...Middleware[T]) Stage[T, T] {
+	for i := len(mws) - 1; i >= 0; i-- {
+		stage = mws[i](stage)
+	}
+	return stage
+}
+
+// LoggingMiddleware logs every element produced by the stage at the debug
+// level and their count at the info level once the iteration ends
+func LoggingMiddleware[T any](logger *slog.Logger) Middleware[T] {
+	return func(stage Stage[T, T]) Stage[T, T] {
+		return func(seq iter.Seq[T]) iter.Seq[T] {
+			return func(yield func(T) bool) {
+				count := 0
+				defer func() {
+					logger.Info("stage done", "count", count)
+				}()
+				for v := range stage(seq) {
+					count++
+					logger.Debug("stage element", "value", v)
+					if !yield(v) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Counter is a monotonic counter, prometheus.Counter satisfies it
+type Counter interface {
+	Inc()
+}
+
+// MetricsMiddleware increments the counter for every element produced by the
+// stage
+func MetricsMiddleware[T any](counter Counter) Middleware[T] {
+	return func(stage Stage[T, T]) Stage[T, T] {
+		return func(seq iter.Seq[T]) iter.Seq[T] {
+			return func(yield func(T) bool) {
+				for v := range stage(seq) {
+					counter.Inc()
+					if !yield(v) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
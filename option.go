@@ -0,0 +1,59 @@
+package it
+
+import "iter"
+
+// Option is a value which may be missing
+type Option[T any] struct {
+	value T
+	valid bool
+}
+
+// Some returns an Option holding v
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, valid: true}
+}
+
+// None returns an empty Option
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Get returns the value and true, or zero value and false for None
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.valid
+}
+
+// IsSome reports whether the Option holds a value
+func (o Option[T]) IsSome() bool {
+	return o.valid
+}
+
+// FilterSome yields the values of Some elements and drops Nones
+func FilterSome[T any](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if !o.valid {
+				continue
+			}
+			if !yield(o.value) {
+				return
+			}
+		}
+	}
+}
+
+// MapOption calls fn on each member of the sequence and yields only the
+// values of returned Somes
+func MapOption[T, V any](seq iter.Seq[T], fn func(T) Option[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			o := fn(v)
+			if !o.valid {
+				continue
+			}
+			if !yield(o.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package it
+
+import "iter"
+
+// Result is either a value or an error
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Get returns the value and the error of the Result
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// IsOk reports whether the Result is successful
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// FilterOk yields the values of successful results and drops the failed ones
+func FilterOk[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.err != nil {
+				continue
+			}
+			if !yield(r.value) {
+				return
+			}
+		}
+	}
+}
+
+// CollectResults drains the sequence and returns the values of successful
+// results and the errors of the failed ones
+func CollectResults[T any](seq iter.Seq[Result[T]]) ([]T, []error) {
+	var values []T
+	var errs []error
+	for r := range seq {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		values = append(values, r.value)
+	}
+	return values, errs
+}
+
+// MapResult calls fn on the values of successful results, the errors of the
+// failed ones are passed through
+func MapResult[T, V any](seq iter.Seq[Result[T]], fn func(T) Result[V]) iter.Seq[Result[V]] {
+	return func(yield func(Result[V]) bool) {
+		for r := range seq {
+			var v Result[V]
+			if r.err != nil {
+				v = Err[V](r.err)
+			} else {
+				v = fn(r.value)
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package it
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Sink is a consumer of a sequence
+type Sink[T any] interface {
+	// Accept consumes a single element
+	Accept(T) error
+	// Flush writes the buffered elements, if any
+	Flush() error
+	// Close flushes and releases the sink
+	Close() error
+}
+
+// Drain accepts all elements of the sequence to sink, then flushes and
+// closes it. It stops at the first error, the sink is closed anyway.
+func Drain[T any](seq iter.Seq[T], sink Sink[T]) (err error) {
+	defer func() {
+		err = errors.Join(err, sink.Close())
+	}()
+	for v := range seq {
+		if err := sink.Accept(v); err != nil {
+			return err
+		}
+	}
+	return sink.Flush()
+}
+
+// SliceSink collects the elements into a slice
+type SliceSink[T any] struct {
+	slice []T
+}
+
+func NewSliceSink[T any]() *SliceSink[T] {
+	return &SliceSink[T]{}
+}
+
+func (s *SliceSink[T]) Accept(v T) error {
+	s.slice = append(s.slice, v)
+	return nil
+}
+
+func (s *SliceSink[T]) Flush() error { return nil }
+func (s *SliceSink[T]) Close() error { return nil }
+
+// Slice returns the collected elements, it remains valid after Close
+func (s *SliceSink[T]) Slice() []T {
+	return s.slice
+}
+
+type discardSink[T any] struct{}
+
+// NewDiscardSink returns a sink discarding all elements
+func NewDiscardSink[T any]() Sink[T] {
+	return discardSink[T]{}
+}
+
+func (discardSink[T]) Accept(T) error { return nil }
+func (discardSink[T]) Flush() error   { return nil }
+func (discardSink[T]) Close() error   { return nil }
+
+type writerSink struct {
+	w      *bufio.Writer
+	format string
+}
+
+// NewWriterSink returns a sink writing each string formatted by format to a
+// buffered w. Closing the sink does not close w.
+func NewWriterSink(w io.Writer, format string) Sink[string] {
+	return &writerSink{w: bufio.NewWriter(w), format: format}
+}
+
+func (s *writerSink) Accept(v string) error {
+	_, err := fmt.Fprintf(s.w, s.format, v)
+	return err
+}
+
+func (s *writerSink) Flush() error { return s.w.Flush() }
+func (s *writerSink) Close() error { return s.w.Flush() }
@@ -0,0 +1,73 @@
+package it
+
+import (
+	"bufio"
+	"iter"
+	"os"
+	"slices"
+)
+
+// Source is a replayable producer of a sequence
+type Source[T any] interface {
+	// Seq returns the sequence of the remaining elements
+	Seq() iter.Seq[T]
+	// Reset rewinds the source to its first element
+	Reset() error
+}
+
+type sliceSource[T any] struct {
+	s []T
+}
+
+// NewSliceSource returns a source of the slice elements, its Reset does
+// nothing as every sequence yields the whole slice
+func NewSliceSource[T any](s []T) Source[T] {
+	return sliceSource[T]{s: s}
+}
+
+func (s sliceSource[T]) Seq() iter.Seq[T] { return slices.Values(s.s) }
+func (s sliceSource[T]) Reset() error     { return nil }
+
+type fileSource struct {
+	path string
+	err  error
+}
+
+// NewFileSource returns a source of the lines of the file at path. Every
+// sequence opens the file, yields its lines from the first one and closes
+// the file when the iteration ends, stopped early or not. An error opening
+// or reading the file ends the sequence and is returned by the next Reset,
+// which otherwise reports whether the file can be opened again.
+func NewFileSource(path string) Source[string] {
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Seq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		f, err := os.Open(s.path)
+		if err != nil {
+			s.err = err
+			return
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+		s.err = scanner.Err()
+	}
+}
+
+func (s *fileSource) Reset() error {
+	if err := s.err; err != nil {
+		s.err = nil
+		return err
+	}
+	f, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
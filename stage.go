@@ -0,0 +1,32 @@
+package it
+
+import "iter"
+
+// Stage is a reusable pipeline step transforming a sequence of A to a
+// sequence of B
+type Stage[A, B any] func(iter.Seq[A]) iter.Seq[B]
+
+// Compose returns a stage running first and then second
+func Compose[A, B, C any](first Stage[A, B], second Stage[B, C]) Stage[A, C] {
+	return func(seq iter.Seq[A]) iter.Seq[C] {
+		return second(first(seq))
+	}
+}
+
+// Identity returns a stage passing the sequence through unchanged
+func Identity[T any]() Stage[T, T] {
+	return func(seq iter.Seq[T]) iter.Seq[T] {
+		return seq
+	}
+}
+
+// Apply runs the stage on the sequence
+func Apply[A, B any](stage Stage[A, B], seq iter.Seq[A]) iter.Seq[B] {
+	return stage(seq)
+}
+
+// Derive is Apply named to point out that the returned sequence is derived
+// from seq and depends on its data, rather than being a pure transformation
+func Derive[T, V any](seq iter.Seq[T], transform func(iter.Seq[T]) iter.Seq[V]) iter.Seq[V] {
+	return Apply(transform, seq)
+}